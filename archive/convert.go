@@ -0,0 +1,105 @@
+package archive
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Artist of track or album (without albums names).
+func NewArtist(artist shared.RemoteArtist) Artist {
+	return Artist{
+		ID:   artist.ID(),
+		Name: artist.Name(),
+	}
+}
+
+// Liked artist (with albums names).
+func NewLikedArtist(ctx context.Context, artist shared.RemoteArtist) (Artist, error) {
+	result := NewArtist(artist)
+	albums, err := artist.OldestAlbumsNames(ctx)
+	if err != nil {
+		return result, err
+	}
+	singles, err := artist.OldestSinglesNames(ctx)
+	if err != nil {
+		return result, err
+	}
+	result.OldestAlbumsNames = albums[:]
+	result.OldestSinglesNames = singles[:]
+	return result, err
+}
+
+func NewAlbum(album shared.RemoteAlbum) Album {
+	result := Album{
+		ID:         album.ID(),
+		Name:       album.Name(),
+		UPC:        album.UPC(),
+		EAN:        album.EAN(),
+		Artists:    newArtists(album.Artists()),
+		TrackCount: album.TrackCount(),
+		Year:       album.Year(),
+	}
+	if cover := album.CoverURL(); cover != nil {
+		result.CoverURL = cover.String()
+	}
+	return result
+}
+
+func NewTrack(track shared.RemoteTrack) Track {
+	result := NewTrackWithoutAlbum(track)
+	album, err := track.Album()
+	if err != nil {
+		// Album is optional.
+		slog.Warn("Track album", "Name", track.Name(), "ID", track.ID().String(), "error", err.Error())
+	} else if !shared.IsNil(album) {
+		conv := NewAlbum(album)
+		result.Album = &conv
+	}
+	return result
+}
+
+// Track.Album() can request remote, so not called.
+func NewTrackWithoutAlbum(track shared.RemoteTrack) Track {
+	result := Track{
+		ID:       track.ID(),
+		Name:     track.Name(),
+		ISRC:     track.ISRC(),
+		Artists:  newArtists(track.Artists()),
+		LengthMs: track.LengthMs(),
+		Year:     track.Year(),
+	}
+	if cover := track.CoverURL(); cover != nil {
+		result.CoverURL = cover.String()
+	}
+	return result
+}
+
+func NewPlaylist(ctx context.Context, playlist shared.RemotePlaylist) (Playlist, error) {
+	result := Playlist{
+		ID:          playlist.ID(),
+		Name:        playlist.Name(),
+		Description: playlist.Description(),
+	}
+	if vis, err := playlist.IsVisible(); err == nil {
+		result.IsVisible = &vis
+	}
+	tracks, err := playlist.Tracks(ctx)
+	if err != nil {
+		return result, err
+	}
+	result.Tracks = make([]Track, len(tracks))
+	for i := range tracks {
+		result.Tracks[i] = NewTrack(tracks[i])
+	}
+	return result, err
+}
+
+func newArtists(artists []shared.RemoteArtist) []Artist {
+	result := make([]Artist, len(artists))
+	for i := range artists {
+		result[i] = NewArtist(artists[i])
+	}
+	return result
+}
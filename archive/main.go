@@ -0,0 +1,152 @@
+// Portable library archive (NDJSON).
+//
+// First line is a header, next lines are records.
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Archive format version. Increment on breaking changes.
+const Version = 1
+
+type RecordType string
+
+const (
+	RecordTypeLikedTrack  RecordType = "likedTrack"
+	RecordTypeLikedAlbum  RecordType = "likedAlbum"
+	RecordTypeLikedArtist RecordType = "likedArtist"
+	RecordTypePlaylist    RecordType = "playlist"
+)
+
+var ErrUnsupportedVersion = errors.New("unsupported archive version")
+
+type Header struct {
+	Version int `json:"version"`
+
+	// Source remote.
+	Remote shared.RemoteName `json:"remote"`
+
+	// Source account.
+	AccountID shared.RepositoryID `json:"accountId"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// One of Track, Album, Artist, Playlist is set (depends on Type).
+type Record struct {
+	Type     RecordType `json:"type"`
+	Track    *Track     `json:"track,omitempty"`
+	Album    *Album     `json:"album,omitempty"`
+	Artist   *Artist    `json:"artist,omitempty"`
+	Playlist *Playlist  `json:"playlist,omitempty"`
+}
+
+type Artist struct {
+	ID   shared.RemoteID `json:"id"`
+	Name string          `json:"name"`
+
+	// Only for liked artists.
+	OldestAlbumsNames  []string `json:"oldestAlbumsNames,omitempty"`
+	OldestSinglesNames []string `json:"oldestSinglesNames,omitempty"`
+}
+
+type Album struct {
+	ID         shared.RemoteID `json:"id"`
+	Name       string          `json:"name"`
+	UPC        *string         `json:"upc,omitempty"`
+	EAN        *string         `json:"ean,omitempty"`
+	Artists    []Artist        `json:"artists"`
+	TrackCount int             `json:"trackCount"`
+	Year       int             `json:"year"`
+	CoverURL   string          `json:"coverUrl,omitempty"`
+}
+
+type Track struct {
+	ID       shared.RemoteID `json:"id"`
+	Name     string          `json:"name"`
+	ISRC     *string         `json:"isrc,omitempty"`
+	Artists  []Artist        `json:"artists"`
+	Album    *Album          `json:"album,omitempty"`
+	LengthMs int             `json:"lengthMs"`
+	Year     int             `json:"year"`
+	CoverURL string          `json:"coverUrl,omitempty"`
+}
+
+type Playlist struct {
+	ID          shared.RemoteID `json:"id"`
+	Name        string          `json:"name"`
+	Description *string         `json:"description,omitempty"`
+
+	// Nil if remote doesn't support visibility.
+	IsVisible *bool   `json:"isVisible,omitempty"`
+	Tracks    []Track `json:"tracks"`
+}
+
+// Writes header first.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Version = Version
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(header); err != nil {
+		return nil, err
+	}
+	return &Writer{enc: enc}, nil
+}
+
+type Writer struct {
+	enc *json.Encoder
+}
+
+func (e Writer) Write(rec Record) error {
+	return e.enc.Encode(rec)
+}
+
+// Reads header first.
+func NewReader(r io.Reader) (*Reader, Header, error) {
+	header := Header{}
+	scanner := bufio.NewScanner(r)
+	// Playlist with tracks can be big.
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, header, err
+		}
+		return nil, header, io.ErrUnexpectedEOF
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, header, err
+	}
+	if header.Version < 1 || header.Version > Version {
+		return nil, header, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+	return &Reader{scanner: scanner}, header, nil
+}
+
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+// Returns io.EOF after last record.
+func (e Reader) Next() (*Record, error) {
+	for e.scanner.Scan() {
+		if len(e.scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := &Record{}
+		if err := json.Unmarshal(e.scanner.Bytes(), rec); err != nil {
+			return nil, err
+		}
+		return rec, nil
+	}
+	if err := e.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,61 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteRead(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer, err := NewWriter(buf, Header{Remote: "Spotify", AccountID: "acc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isrc := "USUM71703861"
+	records := []Record{
+		{Type: RecordTypeLikedTrack, Track: &Track{ID: "1", Name: "Track", ISRC: &isrc, LengthMs: 1000}},
+		{Type: RecordTypePlaylist, Playlist: &Playlist{ID: "2", Name: "Playlist", Tracks: []Track{{ID: "1"}}}},
+	}
+	for _, rec := range records {
+		if err := writer.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reader, header, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Version != Version || header.Remote != "Spotify" {
+		t.Fatalf("bad header: %+v", header)
+	}
+
+	rec, err := reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Type != RecordTypeLikedTrack || rec.Track == nil || *rec.Track.ISRC != isrc {
+		t.Fatalf("bad track: %+v", rec)
+	}
+	rec, err = reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Playlist == nil || len(rec.Playlist.Tracks) != 1 {
+		t.Fatalf("bad playlist: %+v", rec)
+	}
+	if _, err = reader.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	_, _, err := NewReader(strings.NewReader(`{"version":999}`))
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Fatalf("expected ErrUnsupportedVersion, got %v", err)
+	}
+}
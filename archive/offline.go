@@ -0,0 +1,157 @@
+package archive
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Entities from archive. Can be matched with any remote,
+// without requests to source remote.
+
+func NewOfflineArtist(remote shared.RemoteName, artist Artist) *OfflineArtist {
+	return &OfflineArtist{remote: remote, artist: artist}
+}
+
+type OfflineArtist struct {
+	remote shared.RemoteName
+	artist Artist
+}
+
+func (e OfflineArtist) RemoteName() shared.RemoteName {
+	return e.remote
+}
+
+func (e OfflineArtist) ID() shared.RemoteID {
+	return e.artist.ID
+}
+
+func (e OfflineArtist) Name() string {
+	return e.artist.Name
+}
+
+func (e OfflineArtist) OldestAlbumsNames(ctx context.Context) ([20]string, error) {
+	result := [20]string{}
+	copy(result[:], e.artist.OldestAlbumsNames)
+	return result, nil
+}
+
+func (e OfflineArtist) OldestSinglesNames(ctx context.Context) ([20]string, error) {
+	result := [20]string{}
+	copy(result[:], e.artist.OldestSinglesNames)
+	return result, nil
+}
+
+func NewOfflineAlbum(remote shared.RemoteName, album Album) *OfflineAlbum {
+	return &OfflineAlbum{remote: remote, album: album}
+}
+
+type OfflineAlbum struct {
+	remote shared.RemoteName
+	album  Album
+}
+
+func (e OfflineAlbum) RemoteName() shared.RemoteName {
+	return e.remote
+}
+
+func (e OfflineAlbum) ID() shared.RemoteID {
+	return e.album.ID
+}
+
+func (e OfflineAlbum) Name() string {
+	return e.album.Name
+}
+
+func (e OfflineAlbum) UPC() *string {
+	return e.album.UPC
+}
+
+func (e OfflineAlbum) EAN() *string {
+	return e.album.EAN
+}
+
+func (e OfflineAlbum) Artists() []shared.RemoteArtist {
+	return offlineArtists(e.remote, e.album.Artists)
+}
+
+func (e OfflineAlbum) TrackCount() int {
+	return e.album.TrackCount
+}
+
+func (e OfflineAlbum) Year() int {
+	return e.album.Year
+}
+
+func (e OfflineAlbum) CoverURL() *url.URL {
+	return parseURL(e.album.CoverURL)
+}
+
+func NewOfflineTrack(remote shared.RemoteName, track Track) *OfflineTrack {
+	return &OfflineTrack{remote: remote, track: track}
+}
+
+type OfflineTrack struct {
+	remote shared.RemoteName
+	track  Track
+}
+
+func (e OfflineTrack) RemoteName() shared.RemoteName {
+	return e.remote
+}
+
+func (e OfflineTrack) ID() shared.RemoteID {
+	return e.track.ID
+}
+
+func (e OfflineTrack) Name() string {
+	return e.track.Name
+}
+
+func (e OfflineTrack) ISRC() *string {
+	return e.track.ISRC
+}
+
+func (e OfflineTrack) Artists() []shared.RemoteArtist {
+	return offlineArtists(e.remote, e.track.Artists)
+}
+
+func (e OfflineTrack) Album() (shared.RemoteAlbum, error) {
+	if e.track.Album == nil {
+		return nil, nil
+	}
+	return NewOfflineAlbum(e.remote, *e.track.Album), nil
+}
+
+func (e OfflineTrack) LengthMs() int {
+	return e.track.LengthMs
+}
+
+func (e OfflineTrack) Year() int {
+	return e.track.Year
+}
+
+func (e OfflineTrack) CoverURL() *url.URL {
+	return parseURL(e.track.CoverURL)
+}
+
+func offlineArtists(remote shared.RemoteName, artists []Artist) []shared.RemoteArtist {
+	result := make([]shared.RemoteArtist, len(artists))
+	for i := range artists {
+		result[i] = NewOfflineArtist(remote, artists[i])
+	}
+	return result
+}
+
+// Nil if empty or invalid.
+func parseURL(val string) *url.URL {
+	if len(val) == 0 {
+		return nil
+	}
+	parsed, err := url.Parse(val)
+	if err != nil {
+		return nil
+	}
+	return parsed
+}
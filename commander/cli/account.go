@@ -8,7 +8,9 @@ import (
 	"os"
 
 	"github.com/oklookat/synchro/remote/deezer"
+	"github.com/oklookat/synchro/remote/localfiles"
 	"github.com/oklookat/synchro/remote/spotify"
+	"github.com/oklookat/synchro/remote/spotifyexport"
 	"github.com/oklookat/synchro/remote/vkmusic"
 	"github.com/oklookat/synchro/remote/yandexmusic"
 	"github.com/oklookat/synchro/remote/zvuk"
@@ -31,6 +33,7 @@ func (e account) command() *cli.Command {
 			e.delete(),
 			e.changeAlias(),
 			e.reAuth(),
+			e.rekey(),
 		},
 		Usage: "Account(s) actions",
 	}
@@ -127,6 +130,35 @@ func (a account) reAuth() *cli.Command {
 	}
 }
 
+func (e account) rekey() *cli.Command {
+	return &cli.Command{
+		Name:  "rekey",
+		Usage: "Encrypt accounts credentials with new passphrase (empty - decrypt)",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "new-key-file",
+				Usage: "File with new passphrase (first line). Default: SYNCHRO_NEW_KEY or prompt",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			key, err := newAccountsKey(ctx.String("new-key-file"))
+			if err != nil {
+				return err
+			}
+			count, err := repository.Rekey(context.Background(), key)
+			if err != nil {
+				return err
+			}
+			if len(key) == 0 {
+				slog.Info("Accounts decrypted", "count", count)
+				return nil
+			}
+			slog.Info("Accounts encrypted", "count", count)
+			return nil
+		},
+	}
+}
+
 func (e account) add() *cli.Command {
 	_idSecretFlags := []cli.Flag{
 		&cli.StringFlag{
@@ -289,6 +321,50 @@ func (e account) add() *cli.Command {
 					return err
 				},
 			},
+			{
+				Name:    "localfiles",
+				Aliases: []string{"lf"},
+				Usage:   "Add directory with FLAC, MP3, M4A files",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Required: true,
+						Name:     "dir",
+						Usage:    "Music directory. Liked tracks: Liked.m3u, playlists: *.m3u8 in it",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					alias := ctx.String("alias")
+					dir := ctx.String("dir")
+					acc, err := localfiles.NewAccount(context.Background(), alias, dir)
+					if err != nil {
+						return err
+					}
+					e.onAccountCreated(acc)
+					return err
+				},
+			},
+			{
+				Name:    "spotifyexport",
+				Aliases: []string{"spe"},
+				Usage:   "Add Spotify \"Download your data\" archive (read-only, without OAuth)",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Required: true,
+						Name:     "dir",
+						Usage:    "Unpacked archive directory (with YourLibrary.json)",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					alias := ctx.String("alias")
+					dir := ctx.String("dir")
+					acc, err := spotifyexport.NewAccount(context.Background(), alias, dir)
+					if err != nil {
+						return err
+					}
+					e.onAccountCreated(acc)
+					return err
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+type backup struct {
+}
+
+func (e backup) command() *cli.Command {
+	return &cli.Command{
+		Name:  "backup",
+		Usage: "Save account library (liked tracks, albums, artists, playlists) to NDJSON archive",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "account",
+				Aliases:  []string{"a"},
+				Required: true,
+				Usage:    "Account id",
+			},
+			&cli.StringFlag{
+				Name:     "out",
+				Aliases:  []string{"o"},
+				Required: true,
+				Usage:    "Archive path. Example: lib.ndjson",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			acc, err := repository.AccountByID(shared.RepositoryID(ctx.String("account")))
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(acc) {
+				return shared.NewErrAccountNotExists("backup", ctx.String("account"))
+			}
+			return e.backup(acc, ctx.String("out"))
+		},
+	}
+}
+
+func (e backup) backup(acc shared.Account, path string) error {
+	ctx := context.Background()
+
+	acts, err := acc.Actions()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer, err := archive.NewWriter(file, archive.Header{
+		Remote:    acc.RemoteName(),
+		AccountID: acc.ID(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := e.likedTracks(ctx, writer, acts.LikedTracks()); err != nil {
+		return err
+	}
+	if err := e.likedAlbums(ctx, writer, acts.LikedAlbums()); err != nil {
+		return err
+	}
+	if err := e.likedArtists(ctx, writer, acts.LikedArtists()); err != nil {
+		return err
+	}
+	if err := e.playlists(ctx, writer, acts.Playlist()); err != nil {
+		return err
+	}
+
+	slog.Info("Backup saved", "path", path)
+	return file.Close()
+}
+
+func (e backup) likedTracks(ctx context.Context, writer *archive.Writer, act shared.LikedActions) error {
+	slog.Info("Backup", "what", "liked tracks")
+	liked, err := e.liked(ctx, act)
+	if err != nil {
+		return err
+	}
+	for _, ent := range liked {
+		track, ok := ent.(shared.RemoteTrack)
+		if !ok {
+			return fmt.Errorf("not a track: %s", ent.ID())
+		}
+		conv := archive.NewTrack(track)
+		if err := writer.Write(archive.Record{Type: archive.RecordTypeLikedTrack, Track: &conv}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e backup) likedAlbums(ctx context.Context, writer *archive.Writer, act shared.LikedActions) error {
+	slog.Info("Backup", "what", "liked albums")
+	liked, err := e.liked(ctx, act)
+	if err != nil {
+		return err
+	}
+	for _, ent := range liked {
+		album, ok := ent.(shared.RemoteAlbum)
+		if !ok {
+			return fmt.Errorf("not an album: %s", ent.ID())
+		}
+		conv := archive.NewAlbum(album)
+		if err := writer.Write(archive.Record{Type: archive.RecordTypeLikedAlbum, Album: &conv}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e backup) likedArtists(ctx context.Context, writer *archive.Writer, act shared.LikedActions) error {
+	slog.Info("Backup", "what", "liked artists")
+	liked, err := e.liked(ctx, act)
+	if err != nil {
+		return err
+	}
+	for _, ent := range liked {
+		artist, ok := ent.(shared.RemoteArtist)
+		if !ok {
+			return fmt.Errorf("not an artist: %s", ent.ID())
+		}
+		conv, err := archive.NewLikedArtist(ctx, artist)
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(archive.Record{Type: archive.RecordTypeLikedArtist, Artist: &conv}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e backup) playlists(ctx context.Context, writer *archive.Writer, act shared.PlaylistActions) error {
+	slog.Info("Backup", "what", "playlists")
+	if shared.IsNil(act) {
+		return nil
+	}
+	playlists, err := act.MyPlaylists(ctx)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotImplemented) {
+			return nil
+		}
+		return err
+	}
+	for _, playlist := range playlists {
+		conv, err := archive.NewPlaylist(ctx, playlist)
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(archive.Record{Type: archive.RecordTypePlaylist, Playlist: &conv}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Nil if remote doesn't support entities.
+func (e backup) liked(ctx context.Context, act shared.LikedActions) ([]shared.RemoteEntity, error) {
+	if shared.IsNil(act) {
+		return nil, nil
+	}
+	liked, err := act.Liked(ctx)
+	if errors.Is(err, shared.ErrNotImplemented) {
+		return nil, nil
+	}
+	return liked, err
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklookat/synchro/repository"
+	"github.com/urfave/cli/v2"
+)
+
+type db struct {
+}
+
+func (e db) command() *cli.Command {
+	return &cli.Command{
+		Name:  "db",
+		Usage: "Database schema",
+		Subcommands: []*cli.Command{
+			e.status(),
+			e.migrate(),
+		},
+	}
+}
+
+func (e db) status() *cli.Command {
+	return &cli.Command{
+		Name:  "status",
+		Usage: "Show applied and pending migrations",
+		Action: func(ctx *cli.Context) error {
+			migrations, err := repository.Migrations(context.Background())
+			if err != nil {
+				return err
+			}
+			for _, mig := range migrations {
+				if mig.AppliedAt == nil {
+					slog.Info("Pending", "version", mig.Version, "name", mig.Name)
+					continue
+				}
+				slog.Info("Applied", "version", mig.Version, "name", mig.Name, "at", *mig.AppliedAt)
+			}
+			return nil
+		},
+	}
+}
+
+func (e db) migrate() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Apply pending migrations (DB backup created first)",
+		Action: func(ctx *cli.Context) error {
+			applied, err := repository.Migrate(context.Background())
+			if err != nil {
+				return err
+			}
+			if applied == 0 {
+				slog.Info("Schema is up to date")
+				return nil
+			}
+			slog.Info("Migrated", "applied", applied)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,291 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/linking/linkerimpl"
+	"github.com/oklookat/synchro/playlistfile"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/schollz/progressbar/v3"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/time/rate"
+)
+
+// Remote name of CSV tracks.
+//
+// Not a real remote: CSV tracks are matched, but not linked.
+const _csvRemoteName shared.RemoteName = "CSV"
+
+type importer struct {
+}
+
+func (e importer) command() *cli.Command {
+	return &cli.Command{
+		Name:    "import",
+		Aliases: []string{"im"},
+		Usage:   "Import tracks from files",
+		Subcommands: []*cli.Command{
+			e.csv(),
+		},
+	}
+}
+
+func (e importer) csv() *cli.Command {
+	columnFlag := func(name, field string, def []string) cli.Flag {
+		return &cli.StringFlag{
+			Name:  name,
+			Usage: field + " column (default: " + strings.Join(def, " / ") + ")",
+		}
+	}
+	// Flag value or defaults.
+	column := func(ctx *cli.Context, name string, def []string) []string {
+		if ctx.IsSet(name) {
+			return []string{ctx.String(name)}
+		}
+		return def
+	}
+	def := playlistfile.DefaultCSVColumns
+
+	return &cli.Command{
+		Name:  "csv",
+		Usage: "Like tracks from CSV (Exportify, TuneMyMusic) or add them to new playlist",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "in",
+				Aliases:  []string{"i"},
+				Required: true,
+				Usage:    "CSV path. Example: tracks.csv",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Aliases:  []string{"t"},
+				Required: true,
+				Usage:    "To account id",
+			},
+			&cli.StringFlag{
+				Name:    "playlist",
+				Aliases: []string{"p"},
+				Usage:   "Create playlist with this name instead of liking tracks",
+			},
+			&cli.StringFlag{
+				Name:    "report",
+				Aliases: []string{"r"},
+				Usage:   "Not matched tracks CSV path (default: <in>.failed.csv)",
+			},
+			columnFlag("name-column", "Track name", def.Name),
+			columnFlag("artists-column", "Artists", def.Artists),
+			&cli.StringFlag{
+				Name:  "artists-separator",
+				Value: def.ArtistsSeparator,
+				Usage: "Separator of names in artists column. Artists with separator in name will be split",
+			},
+			columnFlag("album-column", "Album", def.Album),
+			columnFlag("isrc-column", "ISRC", def.ISRC),
+			columnFlag("duration-column", "Duration (ms)", def.LengthMs),
+			columnFlag("year-column", "Year or release date", def.Year),
+		},
+		Action: func(ctx *cli.Context) error {
+			acc, err := repository.AccountByID(shared.RepositoryID(ctx.String("to")))
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(acc) {
+				return shared.NewErrAccountNotExists("import csv", ctx.String("to"))
+			}
+
+			columns := playlistfile.CSVColumns{
+				Name:             column(ctx, "name-column", def.Name),
+				Artists:          column(ctx, "artists-column", def.Artists),
+				ArtistsSeparator: ctx.String("artists-separator"),
+				Album:            column(ctx, "album-column", def.Album),
+				ISRC:             column(ctx, "isrc-column", def.ISRC),
+				LengthMs:         column(ctx, "duration-column", def.LengthMs),
+				Year:             column(ctx, "year-column", def.Year),
+			}
+			report := ctx.String("report")
+			if len(report) == 0 {
+				report = ctx.String("in") + ".failed.csv"
+			}
+			return e.importCSV(acc, ctx.String("in"), columns, ctx.String("playlist"), report)
+		},
+	}
+}
+
+func (e importer) importCSV(acc shared.Account, path string, columns playlistfile.CSVColumns, playlistName string, reportPath string) error {
+	ctx := context.Background()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	header, rows, err := playlistfile.ReadCSV(file, columns)
+	if err != nil {
+		return err
+	}
+	slog.Info("CSV", "tracks", len(rows))
+
+	acts, err := acc.Actions()
+	if err != nil {
+		return err
+	}
+	matcher, err := linkerimpl.NewTracksRemote(acc.RemoteName())
+	if err != nil {
+		return err
+	}
+
+	// Target IDs and fail reasons, by row.
+	found, reasons := e.match(ctx, matcher, rows)
+
+	toAdd := []shared.RemoteID{}
+	added := map[shared.RemoteID]bool{}
+	for _, id := range found {
+		if id != nil && !added[*id] {
+			added[*id] = true
+			toAdd = append(toAdd, *id)
+		}
+	}
+
+	if len(playlistName) > 0 {
+		err = e.addToPlaylist(ctx, acts.Playlist(), playlistName, toAdd)
+	} else {
+		err = e.like(ctx, acts.LikedTracks(), toAdd)
+	}
+	if err != nil {
+		return err
+	}
+
+	failed, err := e.writeReport(reportPath, header, rows, reasons)
+	if err != nil {
+		return err
+	}
+	slog.Info("Imported", "matched", len(rows)-failed, "failed", failed)
+	if failed > 0 {
+		slog.Info("Not matched tracks saved", "path", reportPath)
+	}
+	return nil
+}
+
+// Match rows in target. Returns target IDs (nil if failed) and fail reasons.
+func (e importer) match(ctx context.Context, matcher *linkerimpl.TracksRemote, rows []playlistfile.CSVRow) ([]*shared.RemoteID, []string) {
+	found := make([]*shared.RemoteID, len(rows))
+	reasons := make([]string, len(rows))
+
+	limits := matcher.Limits()
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if limits.Rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(limits.Rate), 1)
+	}
+
+	bar := progressbar.Default(int64(len(rows)))
+	bar.Describe("Matching")
+	pool := linker.NewPool(limits.Parallelism)
+	_ = pool.Run(ctx, len(rows), func(ctx context.Context, i int) error {
+		defer bar.Add(1)
+		if err := limiter.Wait(ctx); err != nil {
+			reasons[i] = err.Error()
+			return nil
+		}
+		track := archive.NewOfflineTrack(_csvRemoteName, rows[i].Track)
+		matched, _, err := matcher.Match(ctx, track)
+		switch {
+		case err != nil:
+			// Import as much as possible.
+			reasons[i] = err.Error()
+		case shared.IsNil(matched):
+			reasons[i] = "not found"
+		default:
+			id := matched.ID()
+			found[i] = &id
+		}
+		return nil
+	})
+	bar.Exit()
+
+	return found, reasons
+}
+
+func (e importer) addToPlaylist(ctx context.Context, act shared.PlaylistActions, name string, ids []shared.RemoteID) error {
+	created, err := act.Create(ctx, name, false, nil)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range shared.ChunkSlice(ids, transferLikeChunkSize) {
+		if err := created.AddTracks(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	slog.Info("Playlist created", "Name", name, "ID", created.ID().String(), "added", len(ids))
+	return nil
+}
+
+// Like not liked.
+func (e importer) like(ctx context.Context, act shared.LikedActions, ids []shared.RemoteID) error {
+	liked, err := act.Liked(ctx)
+	if err != nil {
+		return err
+	}
+	present := make(map[shared.RemoteID]bool, len(liked))
+	for _, ent := range liked {
+		present[ent.ID()] = true
+	}
+
+	toLike := []shared.RemoteID{}
+	for _, id := range ids {
+		if !present[id] {
+			toLike = append(toLike, id)
+		}
+	}
+	for _, chunk := range shared.ChunkSlice(toLike, transferLikeChunkSize) {
+		if err := act.Like(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("Liked", "already present", len(ids)-len(toLike), "added", len(toLike))
+	return nil
+}
+
+// Write failed rows as in CSV, with reason column. Nothing written if no fails.
+func (e importer) writeReport(path string, header []string, rows []playlistfile.CSVRow, reasons []string) (int, error) {
+	failed := 0
+	for i := range reasons {
+		if len(reasons[i]) > 0 {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return failed, nil
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return failed, err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(append(append([]string{}, header...), "Reason")); err != nil {
+		return failed, err
+	}
+	for i := range rows {
+		if len(reasons[i]) == 0 {
+			continue
+		}
+		if err := writer.Write(append(append([]string{}, rows[i].Record...), reasons[i])); err != nil {
+			return failed, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return failed, err
+	}
+	return failed, file.Close()
+}
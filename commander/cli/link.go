@@ -0,0 +1,323 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/linking/linkerimpl"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+type link struct {
+}
+
+func (e link) command() *cli.Command {
+	return &cli.Command{
+		Name:    "link",
+		Aliases: []string{"lnk"},
+		Usage:   "Manual links",
+		Subcommands: []*cli.Command{
+			e.set(),
+			e.unpin(),
+			e.export(),
+			e.importLinks(),
+		},
+	}
+}
+
+func (e link) entityFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     "entity",
+		Aliases:  []string{"e"},
+		Value:    "",
+		Required: true,
+		Usage:    "track, album, artist",
+	}
+}
+
+func (e link) set() *cli.Command {
+	return &cli.Command{
+		Name:  "set",
+		Usage: "Create or overwrite link and pin it, so linker never changes it",
+		Flags: []cli.Flag{
+			e.entityFlag(),
+			&cli.StringFlag{
+				Name:     "from",
+				Aliases:  []string{"f"},
+				Value:    "",
+				Required: true,
+				Usage:    "Source <remote>:<id>. Example: Spotify:4uLU6hMCjMI75M1A2tKUQC",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Aliases:  []string{"t"},
+				Value:    "",
+				Required: true,
+				Usage:    "Target <remote>:<id>. Empty id (<remote>:) means missing in target",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			lnk, err := newLinker(ctx.String("entity"))
+			if err != nil {
+				return err
+			}
+			fromRemote, fromID, err := parseRemoteID(ctx.String("from"))
+			if err != nil {
+				return err
+			}
+			if fromID == nil {
+				return errors.New("source id is empty")
+			}
+			toRemote, toID, err := parseRemoteID(ctx.String("to"))
+			if err != nil {
+				return err
+			}
+			if _, err := lnk.LinkManual(context.Background(), fromRemote, *fromID, toRemote, toID); err != nil {
+				return err
+			}
+			slog.Info("Linked and pinned")
+			return nil
+		},
+	}
+}
+
+func (e link) unpin() *cli.Command {
+	return &cli.Command{
+		Name:  "unpin",
+		Usage: "Unpin link, so linker can change it again",
+		Flags: []cli.Flag{
+			e.entityFlag(),
+			&cli.StringFlag{
+				Name:     "from",
+				Aliases:  []string{"f"},
+				Value:    "",
+				Required: true,
+				Usage:    "Source <remote>:<id>",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Aliases:  []string{"t"},
+				Value:    "",
+				Required: true,
+				Usage:    "Target remote",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			entityName := repository.EntityName(ctx.String("entity"))
+			if _, err := newLinker(entityName.String()); err != nil {
+				return err
+			}
+			fromRemote, fromID, err := parseRemoteID(ctx.String("from"))
+			if err != nil {
+				return err
+			}
+			if fromID == nil {
+				return errors.New("source id is empty")
+			}
+			toRemote, err := parseRemoteName(ctx.String("to"))
+			if err != nil {
+				return err
+			}
+
+			sourceLinked, err := repository.NewLinkableEntity(entityName, fromRemote).LinkedRemoteID(context.Background(), *fromID)
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(sourceLinked) {
+				return errors.New("link not exists")
+			}
+			targetLinked, err := repository.NewLinkableEntity(entityName, toRemote).LinkedEntity(context.Background(), sourceLinked.EntityID())
+			if err != nil {
+				return err
+			}
+
+			for _, linked := range []linker.Linked{sourceLinked, targetLinked} {
+				if shared.IsNil(linked) {
+					continue
+				}
+				if err := linked.SetPinned(context.Background(), false); err != nil {
+					return err
+				}
+			}
+			slog.Info("Unpinned")
+			return nil
+		},
+	}
+}
+
+// Shareable entities.
+var _shareableEntities = map[repository.EntityName]linker.RepositoryShareable{
+	repository.EntityNameArtist: repository.ArtistEntity,
+	repository.EntityNameAlbum:  repository.AlbumEntity,
+	repository.EntityNameTrack:  repository.TrackEntity,
+}
+
+// Export and import order.
+var _shareableOrder = []repository.EntityName{repository.EntityNameArtist, repository.EntityNameAlbum, repository.EntityNameTrack}
+
+func (e link) export() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Save artist, album and track links to file, to share or import on fresh install",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "out",
+				Aliases:  []string{"o"},
+				Required: true,
+				Usage:    "File path. Example: links.ndjson",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			return e.exportLinks(ctx.String("out"))
+		},
+	}
+}
+
+func (e link) exportLinks(path string) error {
+	ctx := context.Background()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	writer, err := linker.NewShareWriter(file)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range _shareableOrder {
+		entities, err := _shareableEntities[name].Export(ctx)
+		if err != nil {
+			return err
+		}
+		for _, ent := range entities {
+			if err := writer.Write(linker.ShareRecord{Entity: name.String(), SharedEntity: ent}); err != nil {
+				return err
+			}
+		}
+		slog.Info("Exported", "entity", name, "count", len(entities))
+	}
+	return file.Close()
+}
+
+func (e link) importLinks() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "Import links from file",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "in",
+				Aliases:  []string{"i"},
+				Required: true,
+				Usage:    "File path. Example: links.ndjson",
+			},
+			&cli.StringFlag{
+				Name:    "conflict",
+				Aliases: []string{"c"},
+				Value:   string(linker.ConflictKeepMine),
+				Usage:   "If link differs from existing: mine (keep existing), theirs (take imported), score (keep higher confidence)",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			rule, err := linker.ParseConflictRule(ctx.String("conflict"))
+			if err != nil {
+				return err
+			}
+			return e.importFile(ctx.String("in"), rule)
+		},
+	}
+}
+
+func (e link) importFile(path string, rule linker.ConflictRule) error {
+	ctx := context.Background()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	reader, header, err := linker.NewShareReader(file)
+	if err != nil {
+		return err
+	}
+	slog.Info("Links", "created", header.CreatedAt)
+
+	entities := map[repository.EntityName][]linker.SharedEntity{}
+	for {
+		rec, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := repository.EntityName(rec.Entity)
+		if _, ok := _shareableEntities[name]; !ok {
+			return fmt.Errorf("unknown entity: %s", rec.Entity)
+		}
+		entities[name] = append(entities[name], rec.SharedEntity)
+	}
+
+	for _, name := range _shareableOrder {
+		result, err := _shareableEntities[name].Import(ctx, entities[name], rule)
+		if err != nil {
+			return err
+		}
+		slog.Info("Imported", "entity", name, "created", result.Created,
+			"replaced", result.Replaced, "kept", result.Kept, "skipped", result.Skipped)
+	}
+	return nil
+}
+
+// Example: "track".
+func newLinker(entityName string) (*linker.Static, error) {
+	switch repository.EntityName(entityName) {
+	case repository.EntityNameTrack:
+		return linkerimpl.NewTracks()
+	case repository.EntityNameAlbum:
+		return linkerimpl.NewAlbums()
+	case repository.EntityNameArtist:
+		return linkerimpl.NewArtists()
+	}
+	return nil, fmt.Errorf("unknown entity: %s", entityName)
+}
+
+// Example: "Spotify:4uLU6hMCjMI75M1A2tKUQC".
+//
+// Nil id if empty.
+func parseRemoteID(val string) (shared.RemoteName, *shared.RemoteID, error) {
+	name, id, ok := strings.Cut(val, ":")
+	if !ok {
+		return "", nil, fmt.Errorf("expected <remote>:<id>, got: %s", val)
+	}
+	remoteName, err := parseRemoteName(name)
+	if err != nil {
+		return "", nil, err
+	}
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return remoteName, nil, nil
+	}
+	remoteID := shared.RemoteID(id)
+	return remoteName, &remoteID, nil
+}
+
+// Case insensitive. Example: "spotify".
+func parseRemoteName(val string) (shared.RemoteName, error) {
+	val = strings.TrimSpace(val)
+	for name := range repository.Remotes {
+		if strings.EqualFold(name.String(), val) {
+			return name, nil
+		}
+	}
+	return "", shared.NewErrRemoteNotFound(shared.RemoteName(val))
+}
@@ -7,10 +7,22 @@ import (
 )
 
 func Boot() error {
+	if err := bootAccountsKey(); err != nil {
+		return err
+	}
+
 	acc := account{}
 	tr := transfer{}
 	dest := destruct{}
 	deb := debug{}
+	rev := review{}
+	lnk := link{}
+	syn := synchronize{}
+	bak := backup{}
+	res := restore{}
+	pl := playlist{}
+	im := importer{}
+	database := db{}
 
 	app := &cli.App{
 		Name:  "synchro",
@@ -20,6 +32,14 @@ func Boot() error {
 			tr.command(),
 			dest.command(),
 			deb.command(),
+			rev.command(),
+			lnk.command(),
+			syn.command(),
+			bak.command(),
+			res.command(),
+			pl.command(),
+			im.command(),
+			database.command(),
 		},
 	}
 
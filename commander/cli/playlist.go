@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/linking/linkerimpl"
+	"github.com/oklookat/synchro/playlistfile"
+	"github.com/oklookat/synchro/remote/localfiles"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+var errPlaylistNotFound = errors.New("playlist not found")
+
+type playlist struct {
+}
+
+func (e playlist) command() *cli.Command {
+	return &cli.Command{
+		Name:    "playlist",
+		Aliases: []string{"pl"},
+		Usage:   "Playlist files (M3U8, XSPF, PLS)",
+		Subcommands: []*cli.Command{
+			e.export(),
+			e.importFile(),
+		},
+	}
+}
+
+func (e playlist) export() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Save account playlist to file",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "account",
+				Aliases:  []string{"a"},
+				Required: true,
+				Usage:    "Account id",
+			},
+			&cli.StringFlag{
+				Name:     "playlist",
+				Aliases:  []string{"p"},
+				Required: true,
+				Usage:    "Playlist id (on remote)",
+			},
+			&cli.StringFlag{
+				Name:     "format",
+				Aliases:  []string{"f"},
+				Required: true,
+				Usage:    "xspf, m3u8 or pls",
+			},
+			&cli.StringFlag{
+				Name:     "out",
+				Aliases:  []string{"o"},
+				Required: true,
+				Usage:    "File path. Example: playlist.xspf",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			acc, err := repository.AccountByID(shared.RepositoryID(ctx.String("account")))
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(acc) {
+				return shared.NewErrAccountNotExists("playlist export", ctx.String("account"))
+			}
+			format, err := playlistfile.ParseFormat(ctx.String("format"))
+			if err != nil {
+				return err
+			}
+			return e.exportPlaylist(acc, shared.RemoteID(ctx.String("playlist")), format, ctx.String("out"))
+		},
+	}
+}
+
+func (e playlist) exportPlaylist(acc shared.Account, id shared.RemoteID, format playlistfile.Format, path string) error {
+	ctx := context.Background()
+
+	rem, ok := repository.Remotes[acc.RemoteName()]
+	if !ok {
+		return shared.NewErrRemoteNotFound(acc.RemoteName())
+	}
+	acts, err := acc.Actions()
+	if err != nil {
+		return err
+	}
+	pl, err := acts.Playlist().Playlist(ctx, id)
+	if err != nil {
+		return err
+	}
+	if shared.IsNil(pl) {
+		return errPlaylistNotFound
+	}
+	tracks, err := pl.Tracks(ctx)
+	if err != nil {
+		return err
+	}
+
+	converted := playlistfile.Playlist{
+		Name:   pl.Name(),
+		Tracks: make([]playlistfile.Track, len(tracks)),
+	}
+	for i := range tracks {
+		location := rem.EntityURL(shared.EntityTypeTrack, tracks[i].ID())
+		converted.Tracks[i] = playlistfile.Track{
+			Location: e.location(location),
+			Track:    archive.NewTrack(tracks[i]),
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := playlistfile.Write(file, format, converted); err != nil {
+		return err
+	}
+
+	slog.Info("Playlist exported", "Name", pl.Name(), "tracks", len(tracks), "path", path)
+	return file.Close()
+}
+
+func (e playlist) importFile() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "Create playlist from file on account",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "in",
+				Aliases:  []string{"i"},
+				Required: true,
+				Usage:    "File path. Example: playlist.xspf",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Aliases:  []string{"t"},
+				Required: true,
+				Usage:    "To account id",
+			},
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   "xspf, m3u8 or pls (default: by file extension)",
+			},
+			&cli.StringFlag{
+				Name:    "name",
+				Aliases: []string{"n"},
+				Usage:   "Playlist name (default: from file)",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			acc, err := repository.AccountByID(shared.RepositoryID(ctx.String("to")))
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(acc) {
+				return shared.NewErrAccountNotExists("playlist import", ctx.String("to"))
+			}
+			path := ctx.String("in")
+			var format playlistfile.Format
+			if ctx.IsSet("format") {
+				format, err = playlistfile.ParseFormat(ctx.String("format"))
+			} else {
+				format, err = playlistfile.FormatFromPath(path)
+			}
+			if err != nil {
+				return err
+			}
+			return e.importPlaylist(acc, path, format, ctx.String("name"))
+		},
+	}
+}
+
+func (e playlist) importPlaylist(acc shared.Account, path string, format playlistfile.Format, name string) error {
+	ctx := context.Background()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	read, err := playlistfile.Read(file, format)
+	if err != nil {
+		return err
+	}
+
+	if len(name) == 0 {
+		name = read.Name
+	}
+	if len(name) == 0 {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	base := filepath.Dir(path)
+	tracks := make([]shared.RemoteEntity, len(read.Tracks))
+	for i := range read.Tracks {
+		tracks[i] = e.offlineTrack(read.Tracks[i], base)
+	}
+
+	acts, err := acc.Actions()
+	if err != nil {
+		return err
+	}
+	tracksLnk, err := linkerimpl.NewTracks()
+	if err != nil {
+		return err
+	}
+
+	return restore{}.createPlaylist(ctx, tracksLnk, archive.Playlist{Name: name}, tracks, acc, acts.Playlist())
+}
+
+// Track from file as offline track.
+//
+// Remote detected by location: remote entity URL (like exported), or local file.
+// Unknown locations are local files too, so same location linked once.
+func (e playlist) offlineTrack(track playlistfile.Track, base string) shared.RemoteEntity {
+	loc := strings.TrimSpace(track.Location)
+	parsed, err := url.Parse(loc)
+	if err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") {
+		for name, rem := range repository.Remotes {
+			// Example: http://open.spotify.com/track/
+			prefix := rem.EntityURL(shared.EntityTypeTrack, "x")
+			prefix.Path = strings.TrimSuffix(prefix.Path, "x")
+			if len(prefix.Host) == 0 || !strings.EqualFold(parsed.Host, prefix.Host) {
+				continue
+			}
+			if id, ok := strings.CutPrefix(parsed.Path, prefix.Path); ok && len(id) > 0 {
+				track.ID = shared.RemoteID(id)
+				return archive.NewOfflineTrack(name, track.Track)
+			}
+		}
+	}
+
+	switch {
+	case err == nil && parsed.Scheme == "file":
+		loc = e.location(*parsed)
+		fallthrough
+	case len(loc) > 0 && (err != nil || len(parsed.Scheme) < 2):
+		// Path (or Windows path like C:\Music, scheme "c").
+		if !filepath.IsAbs(loc) {
+			loc = filepath.Join(base, filepath.FromSlash(loc))
+		}
+		if abs, err := filepath.Abs(loc); err == nil {
+			loc = abs
+		}
+		track.ID = shared.RemoteID(filepath.ToSlash(loc))
+	case len(loc) > 0:
+		track.ID = shared.RemoteID(loc)
+	case track.ISRC != nil:
+		track.ID = shared.RemoteID("isrc:" + *track.ISRC)
+	default:
+		track.ID = shared.RemoteID(playlistfile.DisplayTitle(track.Track))
+	}
+	return archive.NewOfflineTrack(localfiles.RemoteName, track.Track)
+}
+
+var _windowsDrivePath = regexp.MustCompile(`^/[A-Za-z]:`)
+
+// File URL to path, other URLs as is.
+func (e playlist) location(loc url.URL) string {
+	if loc.Scheme != "file" {
+		return loc.String()
+	}
+	// "/C:/Music" -> "C:/Music".
+	if _windowsDrivePath.MatchString(loc.Path) {
+		return filepath.FromSlash(loc.Path[1:])
+	}
+	return filepath.FromSlash(loc.Path)
+}
@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/linking/linkerimpl"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+type restore struct {
+}
+
+func (e restore) command() *cli.Command {
+	return &cli.Command{
+		Name:  "restore",
+		Usage: "Restore library from archive (see backup) to any account",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "in",
+				Aliases:  []string{"i"},
+				Required: true,
+				Usage:    "Archive path. Example: lib.ndjson",
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Aliases:  []string{"t"},
+				Required: true,
+				Usage:    "To account id",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			acc, err := repository.AccountByID(shared.RepositoryID(ctx.String("to")))
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(acc) {
+				return shared.NewErrAccountNotExists("restore", ctx.String("to"))
+			}
+			lib, err := e.read(ctx.String("in"))
+			if err != nil {
+				return err
+			}
+			return e.restore(lib, acc)
+		},
+	}
+}
+
+// Archive records, as offline entities.
+type restoreLibrary struct {
+	likedTracks  []shared.RemoteEntity
+	likedAlbums  []shared.RemoteEntity
+	likedArtists []shared.RemoteEntity
+	playlists    []archive.Playlist
+	remote       shared.RemoteName
+}
+
+func (e restore) read(path string) (*restoreLibrary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, header, err := archive.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Archive", "remote", header.Remote.String(), "account", header.AccountID.String(), "created", header.CreatedAt)
+
+	lib := &restoreLibrary{remote: header.Remote}
+	for {
+		rec, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		switch {
+		case rec.Type == archive.RecordTypeLikedTrack && rec.Track != nil:
+			lib.likedTracks = append(lib.likedTracks, archive.NewOfflineTrack(header.Remote, *rec.Track))
+		case rec.Type == archive.RecordTypeLikedAlbum && rec.Album != nil:
+			lib.likedAlbums = append(lib.likedAlbums, archive.NewOfflineAlbum(header.Remote, *rec.Album))
+		case rec.Type == archive.RecordTypeLikedArtist && rec.Artist != nil:
+			lib.likedArtists = append(lib.likedArtists, archive.NewOfflineArtist(header.Remote, *rec.Artist))
+		case rec.Type == archive.RecordTypePlaylist && rec.Playlist != nil:
+			lib.playlists = append(lib.playlists, *rec.Playlist)
+		default:
+			slog.Warn("Unknown record. Skip", "type", rec.Type)
+		}
+	}
+
+	return lib, nil
+}
+
+func (e restore) restore(lib *restoreLibrary, toAcc shared.Account) error {
+	ctx := context.Background()
+
+	acts, err := toAcc.Actions()
+	if err != nil {
+		return err
+	}
+
+	tracksLnk, err := linkerimpl.NewTracks()
+	if err != nil {
+		return err
+	}
+	albumsLnk, err := linkerimpl.NewAlbums()
+	if err != nil {
+		return err
+	}
+	artistsLnk, err := linkerimpl.NewArtists()
+	if err != nil {
+		return err
+	}
+
+	if err := e.liked(ctx, "liked tracks", tracksLnk, lib.likedTracks, toAcc, acts.LikedTracks()); err != nil {
+		return err
+	}
+	if err := e.liked(ctx, "liked albums", albumsLnk, lib.likedAlbums, toAcc, acts.LikedAlbums()); err != nil {
+		return err
+	}
+	if err := e.liked(ctx, "liked artists", artistsLnk, lib.likedArtists, toAcc, acts.LikedArtists()); err != nil {
+		return err
+	}
+
+	for _, playlist := range lib.playlists {
+		if err := e.playlist(ctx, tracksLnk, lib.remote, playlist, toAcc, acts.Playlist()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e restore) liked(
+	ctx context.Context,
+	what string,
+	lnk *linker.Static,
+	entities []shared.RemoteEntity,
+	toAcc shared.Account,
+	act shared.LikedActions,
+) error {
+	if len(entities) == 0 {
+		return nil
+	}
+	slog.Info("Restoring", "what", what, "count", len(entities))
+
+	liked, err := act.Liked(ctx)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotImplemented) {
+			slog.Warn("Not supported by target. Skip", "what", what)
+			return nil
+		}
+		return err
+	}
+	present := make(map[shared.RemoteID]bool, len(liked))
+	for _, ent := range liked {
+		present[ent.ID()] = true
+	}
+
+	targetIDs := e.resolve(ctx, lnk, entities, toAcc.RemoteName())
+
+	toLike := []shared.RemoteID{}
+	notFound, alreadyPresent := 0, 0
+	for _, id := range targetIDs {
+		if id == nil {
+			notFound++
+			continue
+		}
+		if present[*id] {
+			alreadyPresent++
+			continue
+		}
+		present[*id] = true
+		toLike = append(toLike, *id)
+	}
+
+	for _, chunk := range shared.ChunkSlice(toLike, transferLikeChunkSize) {
+		if err := act.Like(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("Restored", "what", what, "already present", alreadyPresent, "added", len(toLike), "not found", notFound)
+	return nil
+}
+
+// Create playlist and add found tracks.
+func (e restore) playlist(
+	ctx context.Context,
+	lnk *linker.Static,
+	remote shared.RemoteName,
+	playlist archive.Playlist,
+	toAcc shared.Account,
+	act shared.PlaylistActions,
+) error {
+	tracks := make([]shared.RemoteEntity, len(playlist.Tracks))
+	for i := range playlist.Tracks {
+		tracks[i] = archive.NewOfflineTrack(remote, playlist.Tracks[i])
+	}
+	return e.createPlaylist(ctx, lnk, playlist, tracks, toAcc, act)
+}
+
+// Create playlist (info tracks not used) and add found tracks.
+func (e restore) createPlaylist(
+	ctx context.Context,
+	lnk *linker.Static,
+	playlist archive.Playlist,
+	tracks []shared.RemoteEntity,
+	toAcc shared.Account,
+	act shared.PlaylistActions,
+) error {
+	slog.Info("Restoring playlist", "Name", playlist.Name, "tracks", len(tracks))
+
+	targetIDs := e.resolve(ctx, lnk, tracks, toAcc.RemoteName())
+
+	isVis := false
+	if playlist.IsVisible != nil {
+		isVis = *playlist.IsVisible
+	}
+	created, err := act.Create(ctx, playlist.Name, isVis, playlist.Description)
+	if err != nil {
+		return err
+	}
+
+	toAdd := []shared.RemoteID{}
+	added := map[shared.RemoteID]bool{}
+	notFound := 0
+	for _, id := range targetIDs {
+		if id == nil {
+			notFound++
+			continue
+		}
+		if !added[*id] {
+			added[*id] = true
+			toAdd = append(toAdd, *id)
+		}
+	}
+	for _, chunk := range shared.ChunkSlice(toAdd, transferLikeChunkSize) {
+		if err := created.AddTracks(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("Restored playlist", "Name", playlist.Name, "added", len(toAdd), "not found", notFound)
+	return nil
+}
+
+// Find entities in target. Same order as entities, nil if not found or failed.
+func (e restore) resolve(ctx context.Context, lnk *linker.Static, entities []shared.RemoteEntity, target shared.RemoteName) []*shared.RemoteID {
+	result := make([]*shared.RemoteID, len(entities))
+	if len(entities) == 0 {
+		return result
+	}
+
+	pool := linker.NewPool(lnk.Workers(entities[0].RemoteName(), target))
+	_ = pool.Run(ctx, len(entities), func(ctx context.Context, i int) error {
+		ent := entities[i]
+		fromRes, err := lnk.FromRemote(ctx, ent, target)
+		if err == nil && !shared.IsNil(fromRes.Linked) {
+			var toRes linker.ToRemoteResult
+			toRes, err = lnk.ToRemoteFrom(ctx, fromRes.Linked, ent, target)
+			if err == nil && !toRes.MissingNow && !shared.IsNil(toRes.Linked) {
+				result[i] = toRes.Linked.RemoteID()
+			}
+		}
+		if err != nil {
+			// Restore as much as possible.
+			slog.Error("Skip", "Name", ent.Name(), "ID", ent.ID().String(), "error", err.Error())
+		}
+		return nil
+	})
+
+	return result
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+type review struct {
+}
+
+func (e review) command() *cli.Command {
+	return &cli.Command{
+		Name:    "review",
+		Aliases: []string{"rev"},
+		Usage:   "Review ambiguous matches",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "entity",
+				Aliases:  []string{"e"},
+				Value:    "",
+				Required: false,
+				Usage:    "Review only: track, album, artist",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			reviews, err := repository.Reviews(context.Background(), repository.EntityName(ctx.String("entity")))
+			if err != nil {
+				return err
+			}
+			if len(reviews) == 0 {
+				slog.Info("Nothing to review")
+				return nil
+			}
+			for i, rev := range reviews {
+				fmt.Printf("\nReview %d/%d (%s)\n", i+1, len(reviews), rev.EntityName())
+				quit, err := e.reviewOne(rev)
+				if err != nil {
+					return err
+				}
+				if quit {
+					break
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Returns true if user wants to stop reviewing.
+func (e review) reviewOne(rev *repository.Review) (bool, error) {
+	candidates, err := rev.Candidates(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Source (%s): %s\n", rev.SourceRemoteName(), rev.SourceName())
+	fmt.Printf("  %s\n", e.entityURL(rev.SourceRemoteName(), rev.EntityName(), rev.SourceID()))
+	fmt.Printf("Candidates (%s):\n", rev.TargetRemoteName())
+	for i, cand := range candidates {
+		fmt.Printf("  %d. %s (score: %.2f)\n", i+1, cand.Name(), cand.Score())
+		fmt.Printf("     %s\n", e.entityURL(rev.TargetRemoteName(), rev.EntityName(), cand.ID()))
+	}
+
+	for {
+		fmt.Println(`Enter candidate number, "r" to reject all (missing), "s" to skip, "q" to quit, or paste target ID:`)
+		input, err := readInput()
+		if err != nil {
+			return false, err
+		}
+		input = strings.TrimSpace(input)
+
+		switch input {
+		case "":
+			continue
+		case "q":
+			return true, nil
+		case "s":
+			return false, nil
+		case "r":
+			return false, e.resolve(rev, nil)
+		}
+
+		if num, err := strconv.Atoi(input); err == nil {
+			if num < 1 || num > len(candidates) {
+				slog.Error("Wrong candidate number")
+				continue
+			}
+			id := candidates[num-1].ID()
+			return false, e.resolve(rev, &id)
+		}
+
+		// Pasted ID. Typo must not become a link.
+		id := shared.RemoteID(input)
+		exists, err := e.targetExists(rev, id)
+		if err != nil {
+			slog.Error(err.Error())
+			continue
+		}
+		if !exists {
+			slog.Error("Target entity not found", "id", input)
+			continue
+		}
+		return false, e.resolve(rev, &id)
+	}
+}
+
+func (e review) resolve(rev *repository.Review, id *shared.RemoteID) error {
+	if err := rev.Resolve(id); err != nil {
+		return err
+	}
+	if id == nil {
+		slog.Info("Marked as missing")
+		return nil
+	}
+	slog.Info("Linked", "targetID", id.String())
+	return nil
+}
+
+func (e review) targetExists(rev *repository.Review, id shared.RemoteID) (bool, error) {
+	lnk, err := newLinker(rev.EntityName().String())
+	if err != nil {
+		return false, err
+	}
+	return lnk.EntityExists(context.Background(), rev.TargetRemoteName(), id)
+}
+
+func (e review) entityURL(remoteName shared.RemoteName, entityName repository.EntityName, id shared.RemoteID) string {
+	rem, err := repository.RemoteByName(remoteName)
+	if err != nil {
+		return id.String()
+	}
+	url := rem.EntityURL(shared.EntityType(entityName), id)
+	return url.String()
+}
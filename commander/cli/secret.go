@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/oklookat/synchro/repository"
+	"golang.org/x/term"
+)
+
+// Accounts passphrase sources (in priority order).
+const (
+	_keyEnv     = "SYNCHRO_KEY"
+	_keyFileEnv = "SYNCHRO_KEY_FILE"
+
+	// For rekey.
+	_newKeyEnv = "SYNCHRO_NEW_KEY"
+)
+
+// Set accounts passphrase once, at start. Wrong passphrase - error.
+func bootAccountsKey() error {
+	ctx := context.Background()
+	encrypted, err := repository.AccountsEncrypted(ctx)
+	if err != nil {
+		return err
+	}
+	key, err := accountsKey(encrypted)
+	if err != nil {
+		return err
+	}
+	if err := repository.SetPassphrase(key); err != nil {
+		return err
+	}
+	return repository.CheckPassphrase(ctx)
+}
+
+// Accounts passphrase: from env, key file or terminal (if accounts encrypted).
+//
+// Empty - accounts not encrypted. To encrypt, use "account rekey".
+func accountsKey(encrypted bool) (string, error) {
+	if key, ok := os.LookupEnv(_keyEnv); ok {
+		return key, nil
+	}
+	if path, ok := os.LookupEnv(_keyFileEnv); ok {
+		return readKeyFile(path)
+	}
+	if !encrypted || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", nil
+	}
+	return readPassphrase("Accounts passphrase: ")
+}
+
+// First line of file.
+func readKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	key, _, _ := strings.Cut(string(data), "\n")
+	return strings.TrimRight(key, "\r"), err
+}
+
+// Without echo.
+func readPassphrase(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	data, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return string(data), err
+}
+
+// New passphrase: from key file, env or terminal (twice).
+func newAccountsKey(keyFile string) (string, error) {
+	if len(keyFile) > 0 {
+		return readKeyFile(keyFile)
+	}
+	if key, ok := os.LookupEnv(_newKeyEnv); ok {
+		return key, nil
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", errors.New("new key not set (use --new-key-file or SYNCHRO_NEW_KEY)")
+	}
+	key, err := readPassphrase("New accounts passphrase (empty - don't encrypt): ")
+	if err != nil {
+		return "", err
+	}
+	again, err := readPassphrase("Repeat: ")
+	if err != nil {
+		return "", err
+	}
+	if key != again {
+		return "", errors.New("passphrases not match")
+	}
+	return key, err
+}
@@ -0,0 +1,381 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/oklookat/synchro/config"
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/linking/linkerimpl"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+type synchronize struct {
+}
+
+func (e synchronize) command() *cli.Command {
+	return &cli.Command{
+		Name:    "sync",
+		Aliases: []string{"s"},
+		Usage:   "Two-way sync of liked tracks between accounts",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:     "account",
+				Aliases:  []string{"a"},
+				Required: true,
+				Usage:    "Account id (two or more). Example: -a id1 -a id2",
+			},
+			&cli.BoolFlag{
+				Name:     "dryRun",
+				Aliases:  []string{"dry-run"},
+				Value:    false,
+				Required: false,
+				Usage:    "Only show changes, without changing accounts",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			accounts, err := e.getAccounts(ctx.StringSlice("account"))
+			if err != nil {
+				return err
+			}
+			cfg, err := config.Get[*config.Sync](config.KeySync)
+			if err != nil {
+				return err
+			}
+			return e.syncLiked(accounts, (*cfg).Conflict, ctx.Bool("dryRun"))
+		},
+	}
+}
+
+func (e synchronize) getAccounts(ids []string) ([]shared.Account, error) {
+	if len(ids) < 2 {
+		return nil, errors.New("two or more accounts required")
+	}
+	accounts := make([]shared.Account, 0, len(ids))
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate account: %s", id)
+		}
+		seen[id] = true
+		acc, err := repository.AccountByID(shared.RepositoryID(id))
+		if err != nil {
+			return nil, err
+		}
+		if shared.IsNil(acc) {
+			return nil, shared.NewErrAccountNotExists("sync", id)
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+// Account state in sync.
+type syncAccount struct {
+	account shared.Account
+	actions shared.LikedActions
+
+	// Liked now (remote order).
+	liked []shared.RemoteEntity
+
+	// Liked now.
+	current map[shared.RemoteID]bool
+
+	// Liked at last sync. Nil if not synced before.
+	previous map[shared.RemoteID]bool
+
+	// Changes to apply.
+	toLike, toUnlike []shared.RemoteID
+}
+
+// Liked in one account since last sync.
+type syncAdded struct {
+	from   *syncAccount
+	linked linker.Linked
+}
+
+func (e synchronize) syncLiked(accounts []shared.Account, conflict config.SyncConflict, dryRun bool) error {
+	ctx := context.Background()
+
+	ids := make([]shared.RepositoryID, len(accounts))
+	for i := range accounts {
+		ids[i] = accounts[i].ID()
+	}
+	syncKey := repository.SyncKey(ids)
+
+	states := make([]*syncAccount, len(accounts))
+	for i, acc := range accounts {
+		state, err := e.getState(ctx, syncKey, acc)
+		if err != nil {
+			return err
+		}
+		states[i] = state
+	}
+
+	lnk, err := linkerimpl.NewTracks()
+	if err != nil {
+		return err
+	}
+
+	added, err := e.added(ctx, lnk, states)
+	if err != nil {
+		return err
+	}
+	removed, err := e.removed(states)
+	if err != nil {
+		return err
+	}
+
+	// Both sides changed.
+	for entityID := range added {
+		if _, ok := removed[entityID]; !ok {
+			continue
+		}
+		slog.Warn("Conflict (liked and unliked)", "rule", conflict, "entityID", entityID.String())
+		switch conflict {
+		case config.SyncConflictLike:
+			delete(removed, entityID)
+		case config.SyncConflictUnlike:
+			// Unlike in account that liked it too.
+			delete(added, entityID)
+		case config.SyncConflictSkip:
+			delete(added, entityID)
+			delete(removed, entityID)
+		}
+	}
+
+	if err := e.planLikes(ctx, lnk, states, added); err != nil {
+		return err
+	}
+	if err := e.planUnlikes(states, removed); err != nil {
+		return err
+	}
+
+	for _, state := range states {
+		slog.Info("Changes", "account", state.account.ID().String(), "remote", state.account.RemoteName().String(),
+			"like", len(state.toLike), "unlike", len(state.toUnlike))
+	}
+	if dryRun {
+		slog.Info("Dry run. Accounts not changed")
+		return nil
+	}
+
+	for _, state := range states {
+		if err := e.apply(ctx, syncKey, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e synchronize) getState(ctx context.Context, syncKey string, acc shared.Account) (*syncAccount, error) {
+	actions, err := acc.Actions()
+	if err != nil {
+		return nil, err
+	}
+	state := &syncAccount{
+		account: acc,
+		actions: actions.LikedTracks(),
+		current: map[shared.RemoteID]bool{},
+	}
+
+	slog.Info("Getting liked...", "account id", acc.ID())
+	if state.liked, err = state.actions.Liked(ctx); err != nil {
+		return nil, err
+	}
+	for _, ent := range state.liked {
+		state.current[ent.ID()] = true
+	}
+
+	snapshot, err := repository.LikedSnapshot(syncKey, acc.ID(), repository.EntityNameTrack)
+	if err != nil || snapshot == nil {
+		return state, err
+	}
+	prevIDs, err := snapshot.Liked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state.previous = make(map[shared.RemoteID]bool, len(prevIDs))
+	for _, id := range prevIDs {
+		state.previous[id] = true
+	}
+
+	return state, err
+}
+
+// Entities liked since last sync (all liked, if not synced before).
+func (e synchronize) added(ctx context.Context, lnk *linker.Static, states []*syncAccount) (map[shared.EntityID]syncAdded, error) {
+	result := map[shared.EntityID]syncAdded{}
+
+	for i, state := range states {
+		newLiked := []shared.RemoteEntity{}
+		for _, ent := range state.liked {
+			if !state.previous[ent.ID()] {
+				newLiked = append(newLiked, ent)
+			}
+		}
+		if len(newLiked) == 0 {
+			continue
+		}
+
+		// Any other remote to search in.
+		target := states[(i+1)%len(states)].account.RemoteName()
+
+		slog.Info("Linking liked", "account", state.account.ID().String(), "count", len(newLiked))
+		linked := make([]linker.Linked, len(newLiked))
+		pool := linker.NewPool(lnk.Workers(state.account.RemoteName(), target))
+		err := pool.Run(ctx, len(newLiked), func(ctx context.Context, i int) error {
+			res, err := lnk.FromRemote(ctx, newLiked[i], target)
+			linked[i] = res.Linked
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lnkd := range linked {
+			if shared.IsNil(lnkd) {
+				continue
+			}
+			if _, ok := result[lnkd.EntityID()]; !ok {
+				result[lnkd.EntityID()] = syncAdded{from: state, linked: lnkd}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Entities unliked since last sync.
+func (e synchronize) removed(states []*syncAccount) (map[shared.EntityID]bool, error) {
+	result := map[shared.EntityID]bool{}
+
+	for _, state := range states {
+		linkables := repository.NewLinkableEntity(repository.EntityNameTrack, state.account.RemoteName())
+		for id := range state.previous {
+			if state.current[id] {
+				continue
+			}
+			linked, err := linkables.LinkedRemoteID(context.Background(), id)
+			if err != nil {
+				return nil, err
+			}
+			if shared.IsNil(linked) {
+				// Never linked, so never synced.
+				continue
+			}
+			result[linked.EntityID()] = true
+		}
+	}
+
+	return result, nil
+}
+
+func (e synchronize) planLikes(ctx context.Context, lnk *linker.Static, states []*syncAccount, added map[shared.EntityID]syncAdded) error {
+	type task struct {
+		add syncAdded
+		to  *syncAccount
+	}
+	tasks := []task{}
+	for _, add := range added {
+		for _, state := range states {
+			if state != add.from {
+				tasks = append(tasks, task{add: add, to: state})
+			}
+		}
+	}
+
+	targetIDs := make([]*shared.RemoteID, len(tasks))
+	pool := linker.NewPool(lnk.Workers(e.remoteNames(states)...))
+	err := pool.Run(ctx, len(tasks), func(ctx context.Context, i int) error {
+		res, err := lnk.ToRemote(ctx, tasks[i].add.linked, tasks[i].add.from.account.RemoteName(), tasks[i].to.account.RemoteName())
+		if err != nil || res.MissingNow || shared.IsNil(res.Linked) {
+			return err
+		}
+		targetIDs[i] = res.Linked.RemoteID()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, tsk := range tasks {
+		if targetIDs[i] == nil {
+			slog.Warn("Not found", "remote", tsk.to.account.RemoteName().String(), "entityID", tsk.add.linked.EntityID().String())
+			continue
+		}
+		if tsk.to.current[*targetIDs[i]] {
+			// Liked already.
+			continue
+		}
+		tsk.to.toLike = append(tsk.to.toLike, *targetIDs[i])
+		tsk.to.current[*targetIDs[i]] = true
+	}
+
+	return nil
+}
+
+func (e synchronize) planUnlikes(states []*syncAccount, removed map[shared.EntityID]bool) error {
+	for _, state := range states {
+		linkables := repository.NewLinkableEntity(repository.EntityNameTrack, state.account.RemoteName())
+		for entityID := range removed {
+			linked, err := linkables.LinkedEntity(context.Background(), entityID)
+			if err != nil {
+				return err
+			}
+			if shared.IsNil(linked) || linked.RemoteID() == nil {
+				continue
+			}
+			if state.current[*linked.RemoteID()] {
+				state.toUnlike = append(state.toUnlike, *linked.RemoteID())
+			}
+		}
+	}
+	return nil
+}
+
+// Like, unlike and save snapshot.
+func (e synchronize) apply(ctx context.Context, syncKey string, state *syncAccount) error {
+	for _, chunk := range shared.ChunkSlice(state.toLike, transferLikeChunkSize) {
+		if err := state.actions.Like(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	for _, chunk := range shared.ChunkSlice(state.toUnlike, transferLikeChunkSize) {
+		if err := state.actions.Unlike(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	unliked := make(map[shared.RemoteID]bool, len(state.toUnlike))
+	for _, id := range state.toUnlike {
+		unliked[id] = true
+	}
+	// Current includes planned likes.
+	liked := make([]shared.RemoteID, 0, len(state.current))
+	for id := range state.current {
+		if !unliked[id] {
+			liked = append(liked, id)
+		}
+	}
+
+	return repository.SaveLikedSnapshot(ctx, syncKey, state.account.ID(), repository.EntityNameTrack, liked)
+}
+
+func (e synchronize) remoteNames(states []*syncAccount) []shared.RemoteName {
+	names := []shared.RemoteName{}
+	seen := map[shared.RemoteName]bool{}
+	for _, state := range states {
+		name := state.account.RemoteName()
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
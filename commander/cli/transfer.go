@@ -2,8 +2,12 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
+	"sort"
+	"time"
 
 	"github.com/oklookat/synchro/linking/linker"
 	"github.com/oklookat/synchro/linking/linkerimpl"
@@ -13,7 +17,41 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// Transfer job sections.
+const (
+	transferSectionLikedAlbums  = "likedAlbums"
+	transferSectionLikedArtists = "likedArtists"
+	transferSectionLikedTracks  = "likedTracks"
+	transferSectionPlaylist     = "playlist"
+)
+
+// Entities per Like request. After each chunk, progress is saved.
+const transferLikeChunkSize = 50
+
 type transfer struct {
+	// Links with lower match score will be skipped.
+	minScore float64
+
+	// Error policy. Example: transferOnErrorSkip.
+	onError string
+
+	// Retries count, if onError is transferOnErrorRetry.
+	retries int
+
+	failures *transferFailures
+}
+
+// Saved with job, so it can be resumed with the same options.
+type transferOptions struct {
+	LikedAlbums  bool    `json:"likedAlbums"`
+	LikedArtists bool    `json:"likedArtists"`
+	LikedTracks  bool    `json:"likedTracks"`
+	Playlists    bool    `json:"playlists"`
+	DryRun       bool    `json:"dryRun"`
+	Mirror       bool    `json:"mirror"`
+	MinScore     float64 `json:"minScore"`
+	OnError      string  `json:"onError"`
+	Retries      int     `json:"retries"`
 }
 
 func (e transfer) command() *cli.Command {
@@ -25,16 +63,23 @@ func (e transfer) command() *cli.Command {
 				Name:     "from",
 				Aliases:  []string{"f"},
 				Value:    "",
-				Required: true,
+				Required: false,
 				Usage:    "From account id",
 			},
 			&cli.StringFlag{
 				Name:     "to",
 				Aliases:  []string{"t"},
 				Value:    "",
-				Required: true,
+				Required: false,
 				Usage:    "To account id",
 			},
+			&cli.StringFlag{
+				Name:     "resume",
+				Aliases:  []string{"r"},
+				Value:    "",
+				Required: false,
+				Usage:    "Resume transfer job by id (other flags except report will be ignored)",
+			},
 			&cli.BoolFlag{
 				Name:     "likedAlbums",
 				Aliases:  []string{"lab"},
@@ -63,139 +108,288 @@ func (e transfer) command() *cli.Command {
 				Required: false,
 				Usage:    "Playlists?",
 			},
+			&cli.BoolFlag{
+				Name:     "dryRun",
+				Aliases:  []string{"dry-run"},
+				Value:    false,
+				Required: false,
+				Usage:    "Only link and write a match report, without changing the target account",
+			},
+			&cli.BoolFlag{
+				Name:     "mirror",
+				Value:    false,
+				Required: false,
+				Usage:    "Also unlike entities in target that not liked in source (liked albums, artists, tracks). Asks for confirmation",
+			},
+			&cli.StringFlag{
+				Name:     "report",
+				Value:    "",
+				Required: false,
+				Usage:    "Dry run report path (CSV). Stdout if empty",
+			},
+			&cli.Float64Flag{
+				Name:     "minScore",
+				Aliases:  []string{"min-score"},
+				Value:    0,
+				Required: false,
+				Usage:    "Skip links with lower match score (0-1). Exact and manual links are never skipped",
+			},
+			&cli.StringFlag{
+				Name:     "onError",
+				Aliases:  []string{"on-error"},
+				Value:    transferOnErrorAbort,
+				Required: false,
+				Usage:    "What to do when entity transfer fails: abort, skip, retry (then skip)",
+			},
+			&cli.IntFlag{
+				Name:     "retries",
+				Value:    3,
+				Required: false,
+				Usage:    "Retries count for --onError retry. Delay doubles after each retry",
+			},
+		},
+		Subcommands: []*cli.Command{
+			e.jobs(),
+			e.undo(),
 		},
 		Usage: "Transfer entities between accounts",
 		Action: func(ctx *cli.Context) error {
-			from := ctx.String("from")
-			fromAcc, err := e.getAcc(from)
+			job, opts, err := e.getJob(ctx)
 			if err != nil {
 				return err
 			}
-			to := ctx.String("to")
-			toAcc, err := e.getAcc(to)
+			fromAcc, err := e.getAcc(job.FromAccountID().String())
+			if err != nil {
+				return err
+			}
+			toAcc, err := e.getAcc(job.ToAccountID().String())
 			if err != nil {
 				return err
 			}
 
-			if ctx.Bool("likedAlbums") {
-				slog.Info("Transfering", "what", "liked albums")
-				lnk, err := linkerimpl.NewAlbums()
-				if err != nil {
-					return err
-				}
-				fromActs, err := fromAcc.Actions()
-				if err != nil {
-					return err
-				}
-				fromAct := fromActs.LikedAlbums()
-				toActs, err := toAcc.Actions()
-				if err != nil {
-					return err
-				}
-				toAct := toActs.LikedAlbums()
-				if err := e.transferBtw(lnk, fromAcc, toAcc, fromAct, toAct); err != nil {
-					return err
-				}
+			slog.Info("Transfer job", "id", job.ID().String())
+
+			e.minScore = opts.MinScore
+			e.onError = opts.OnError
+			if len(e.onError) == 0 {
+				// Job created before error policies.
+				e.onError = transferOnErrorAbort
 			}
+			e.retries = opts.Retries
+			e.failures = newTransferFailures()
 
-			if ctx.Bool("likedArtists") {
-				slog.Info("Transfering", "what", "liked artists")
-				lnk, err := linkerimpl.NewArtists()
-				if err != nil {
-					return err
-				}
-				fromActs, err := fromAcc.Actions()
-				if err != nil {
-					return err
-				}
-				fromAct := fromActs.LikedArtists()
-				toActs, err := toAcc.Actions()
-				if err != nil {
-					return err
-				}
-				toAct := toActs.LikedArtists()
-				if err := e.transferBtw(lnk, fromAcc, toAcc, fromAct, toAct); err != nil {
-					return err
-				}
+			var report *transferReport
+			if opts.DryRun {
+				slog.Info("Dry run. Target account will not be changed")
+				report = newTransferReport(toAcc)
 			}
 
-			if ctx.Bool("likedTracks") {
-				slog.Info("Transfering", "what", "liked tracks")
-				lnk, err := linkerimpl.NewTracks()
-				if err != nil {
-					return err
-				}
-				fromActs, err := fromAcc.Actions()
-				if err != nil {
-					return err
-				}
-				fromAct := fromActs.LikedTracks()
-				toActs, err := toAcc.Actions()
-				if err != nil {
-					return err
-				}
-				toAct := toActs.LikedTracks()
-				if err := e.transferBtw(lnk, fromAcc, toAcc, fromAct, toAct); err != nil {
-					return err
-				}
+			if err := job.SetStatus(repository.TransferJobStatusRunning); err != nil {
+				return err
 			}
 
-			if ctx.Bool("playlists") {
-				slog.Info("Transfering", "what", "playlists")
-				lnk, err := linkerimpl.NewTracks()
-				if err != nil {
-					return err
-				}
-				fromActs, err := fromAcc.Actions()
-				if err != nil {
-					return err
-				}
-				fromAct := fromActs.Playlist()
-				toActs, err := toAcc.Actions()
-				if err != nil {
-					return err
+			err = e.run(job, opts, fromAcc, toAcc, report)
+			e.failures.print()
+			if err == nil && e.failures.count() > 0 {
+				err = errTransferHasFailures
+			}
+			if err != nil {
+				if errSt := job.SetStatus(repository.TransferJobStatusFailed); errSt != nil {
+					return errSt
 				}
-				toAct := toActs.Playlist()
+				slog.Error("Transfer failed. To continue, run", "command", "transfer --resume "+job.ID().String())
+				return err
+			}
 
-				rCtx := context.Background()
+			if err := job.SetStatus(repository.TransferJobStatusDone); err != nil {
+				return err
+			}
 
-				fromPlaylists, err := fromAct.MyPlaylists(rCtx)
-				if err != nil {
-					return err
-				}
+			if report != nil {
+				return report.write(ctx.String("report"))
+			}
 
-				for _, fromPlaylist := range fromPlaylists {
-					slog.Info("Current playlist", "Name", fromPlaylist.Name())
+			return nil
+		},
+	}
+}
 
-					isVis, _ := fromPlaylist.IsVisible()
+func (e transfer) jobs() *cli.Command {
+	return &cli.Command{
+		Name:  "jobs",
+		Usage: "Show transfer jobs",
+		Action: func(ctx *cli.Context) error {
+			jobs, err := repository.TransferJobs(context.Background())
+			if err != nil {
+				return err
+			}
+			for _, job := range jobs {
+				fmt.Printf("ID: %s | Created: %s | From: %s | To: %s | Status: %s\n",
+					job.ID(), job.CreatedAt().Format("15:04:05 02.01.06"),
+					job.FromAccountID(), job.ToAccountID(), job.Status())
+			}
+			return nil
+		},
+	}
+}
 
-					toPlaylist, err := toAct.Create(rCtx, fromPlaylist.Name(), isVis, fromPlaylist.Description())
-					if err != nil {
-						return err
-					}
+// Create job from flags, or get job to resume.
+func (e transfer) getJob(ctx *cli.Context) (*repository.TransferJob, transferOptions, error) {
+	opts := transferOptions{}
 
-					fromWrapAct := playlistLikedActions{pl: fromPlaylist}
-					toWrapAct := playlistLikedActions{pl: toPlaylist}
+	if resumeID := ctx.String("resume"); len(resumeID) > 0 {
+		job, err := repository.TransferJobByID(shared.RepositoryID(resumeID))
+		if err != nil {
+			return nil, opts, err
+		}
+		if job == nil {
+			return nil, opts, errors.New("transfer job not exists")
+		}
+		if job.Status() == repository.TransferJobStatusDone {
+			return nil, opts, errors.New("transfer job already done")
+		}
+		err = json.Unmarshal([]byte(job.Options()), &opts)
+		return job, opts, err
+	}
 
-					if err := e.transferBtw(lnk, fromAcc, toAcc, fromWrapAct, toWrapAct); err != nil {
-						toAct.Delete(rCtx, []shared.RemoteID{toPlaylist.ID()})
-						return err
-					}
-				}
+	from := ctx.String("from")
+	to := ctx.String("to")
+	if len(from) == 0 || len(to) == 0 {
+		return nil, opts, errors.New("from and to accounts required")
+	}
+	if _, err := e.getAcc(from); err != nil {
+		return nil, opts, err
+	}
+	if _, err := e.getAcc(to); err != nil {
+		return nil, opts, err
+	}
+
+	opts.LikedAlbums = ctx.Bool("likedAlbums")
+	opts.LikedArtists = ctx.Bool("likedArtists")
+	opts.LikedTracks = ctx.Bool("likedTracks")
+	opts.Playlists = ctx.Bool("playlists")
+	opts.DryRun = ctx.Bool("dryRun")
+	opts.Mirror = ctx.Bool("mirror")
+	opts.MinScore = ctx.Float64("minScore")
+	opts.OnError = ctx.String("onError")
+	opts.Retries = ctx.Int("retries")
+	if err := checkTransferOnError(opts.OnError); err != nil {
+		return nil, opts, err
+	}
+
+	optsBytes, err := json.Marshal(opts)
+	if err != nil {
+		return nil, opts, err
+	}
+
+	job, err := repository.CreateTransferJob(shared.RepositoryID(from), shared.RepositoryID(to), string(optsBytes))
+	return job, opts, err
+}
+
+func (e transfer) run(
+	job *repository.TransferJob,
+	opts transferOptions,
+	fromAcc, toAcc shared.Account,
+	report *transferReport,
+) error {
+	fromActs, err := fromAcc.Actions()
+	if err != nil {
+		return err
+	}
+	toActs, err := toAcc.Actions()
+	if err != nil {
+		return err
+	}
+
+	if opts.LikedAlbums {
+		slog.Info("Transfering", "what", "liked albums")
+		lnk, err := linkerimpl.NewAlbums()
+		if err != nil {
+			return err
+		}
+		section, err := job.Section(transferSectionLikedAlbums, "")
+		if err != nil {
+			return err
+		}
+		if err := e.transferBtw(lnk, section, fromAcc, toAcc, fromActs.LikedAlbums(), toActs.LikedAlbums(), report.section("liked albums")); err != nil {
+			return err
+		}
+		if opts.Mirror {
+			if err := e.mirror(section, toActs.LikedAlbums(), opts.DryRun); err != nil {
+				return err
 			}
+		}
+	}
 
-			return nil
-		},
+	if opts.LikedArtists {
+		slog.Info("Transfering", "what", "liked artists")
+		lnk, err := linkerimpl.NewArtists()
+		if err != nil {
+			return err
+		}
+		section, err := job.Section(transferSectionLikedArtists, "")
+		if err != nil {
+			return err
+		}
+		if err := e.transferBtw(lnk, section, fromAcc, toAcc, fromActs.LikedArtists(), toActs.LikedArtists(), report.section("liked artists")); err != nil {
+			return err
+		}
+		if opts.Mirror {
+			if err := e.mirror(section, toActs.LikedArtists(), opts.DryRun); err != nil {
+				return err
+			}
+		}
 	}
+
+	if opts.LikedTracks {
+		slog.Info("Transfering", "what", "liked tracks")
+		lnk, err := linkerimpl.NewTracks()
+		if err != nil {
+			return err
+		}
+		section, err := job.Section(transferSectionLikedTracks, "")
+		if err != nil {
+			return err
+		}
+		if err := e.transferBtw(lnk, section, fromAcc, toAcc, fromActs.LikedTracks(), toActs.LikedTracks(), report.section("liked tracks")); err != nil {
+			return err
+		}
+		if opts.Mirror {
+			if err := e.mirror(section, toActs.LikedTracks(), opts.DryRun); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.Playlists {
+		slog.Info("Transfering", "what", "playlists")
+		lnk, err := linkerimpl.NewTracks()
+		if err != nil {
+			return err
+		}
+		if err := e.transferPlaylists(lnk, job, fromAcc, toAcc, fromActs.Playlist(), toActs.Playlist(), report); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
+// If report not nil, toAct not used and nothing will be liked.
 func (e transfer) transferBtw(
 	lnk *linker.Static,
+	section *repository.TransferSection,
 	fromAcc shared.Account, toAcc shared.Account,
 	fromAct shared.LikedActions, toAct shared.LikedActions,
+	report *transferReportSection,
 ) error {
 	ctx := context.Background()
 
+	if section.Done() && report == nil {
+		slog.Info("Already transferred", "section", section.Kind())
+		return nil
+	}
+
 	slog.Info("Transfer BTW",
 		"from remote",
 		fromAcc.RemoteName().String(),
@@ -209,76 +403,245 @@ func (e transfer) transferBtw(
 		return err
 	}
 
-	bar := progressbar.Default(int64(len(liked)))
-	bar.Describe("Linking (Remote -> DB)")
+	items, err := section.SyncItems(ctx, liked)
+	if err != nil {
+		return err
+	}
 
-	fromLinkedList := []linker.Linked{}
-	for _, ent := range liked {
-		linkedRes, err := lnk.FromRemote(ctx, ent, toAcc.RemoteName())
-		if err != nil {
+	pool := linker.NewPool(lnk.Workers(fromAcc.RemoteName(), toAcc.RemoteName()))
+
+	// Liked in target before transfer.
+	// Will not be liked again or saved as changes, so undo keeps them.
+	present := map[shared.RemoteID]bool{}
+	if !shared.IsNil(toAct) {
+		if present, err = e.targetLiked(ctx, lnk, pool, fromAcc.RemoteName(), toAct); err != nil {
 			return err
 		}
-		fromLinkedList = append(fromLinkedList, linkedRes.Linked)
-		bar.Add(1)
 	}
+
+	bar := progressbar.Default(int64(len(liked)))
+	bar.Describe("Linking (Remote -> DB)")
+
+	// Results and errors by index, so order is the same as in source.
+	fromLinkedList := make([]linker.Linked, len(liked))
+	errs := make([]error, len(liked))
+	runErr := pool.Run(ctx, len(liked), func(ctx context.Context, i int) error {
+		defer bar.Add(1)
+		if items[i].Status() == repository.TransferItemStatusLiked {
+			return nil
+		}
+		errs[i] = e.try(ctx, func() error {
+			linkedRes, err := lnk.FromRemote(ctx, liked[i], toAcc.RemoteName())
+			fromLinkedList[i] = linkedRes.Linked
+			return err
+		})
+		return e.stopOnError(errs[i])
+	})
 	bar.Exit()
+	hasFailed, err := e.failedInOrder(section, items, errs, runErr)
+	if err != nil {
+		return err
+	}
 
 	slog.Info("Why don't we have a cup of tea? 🤔")
 
 	bar = progressbar.Default(int64(len(fromLinkedList)))
 	bar.Describe("Linking (DB -> Remote)")
 
-	toLinkedIds := []shared.RemoteID{}
-	for i, linked := range fromLinkedList {
-		res, err := lnk.ToRemote(ctx, linked, fromAcc.RemoteName(), toAcc.RemoteName())
-		if err != nil {
+	toResults := make([]linker.ToRemoteResult, len(fromLinkedList))
+	errs = make([]error, len(fromLinkedList))
+	runErr = pool.Run(ctx, len(fromLinkedList), func(ctx context.Context, i int) error {
+		defer bar.Add(1)
+		if shared.IsNil(fromLinkedList[i]) {
+			// Liked before or failed.
+			return nil
+		}
+		errs[i] = e.try(ctx, func() (err error) {
+			toResults[i], err = lnk.ToRemote(ctx, fromLinkedList[i], fromAcc.RemoteName(), toAcc.RemoteName())
 			return err
+		})
+		return e.stopOnError(errs[i])
+	})
+	bar.Exit()
+	toFailed, err := e.failedInOrder(section, items, errs, runErr)
+	if err != nil {
+		return err
+	}
+	hasFailed = hasFailed || toFailed
+
+	counts := transferCounts{}
+	toLike := []*repository.TransferItem{}
+	for i, linked := range fromLinkedList {
+		if shared.IsNil(linked) || errs[i] != nil {
+			continue
 		}
+		res := toResults[i]
 		if res.MissingNow || shared.IsNil(res.Linked) || res.Linked.RemoteID() == nil {
 			slog.Warn("Not found", "Name", liked[i].Name(), "ID", liked[i].ID().String())
-			bar.Add(1)
+			report.add(liked[i], nil, res.Match)
+			if err := items[i].SetLinked(nil); err != nil {
+				return err
+			}
+			counts.notFound++
+			continue
+		}
+		match := res.Linked.Match()
+		if e.isLowScore(match) {
+			slog.Warn("Low match score", "Name", liked[i].Name(), "ID", liked[i].ID().String(), "score", match.Score)
+			report.add(liked[i], nil, match)
+			if err := items[i].SetLinked(nil); err != nil {
+				return err
+			}
+			counts.notFound++
+			continue
+		}
+		report.add(liked[i], res.Linked.RemoteID(), match)
+		if err := items[i].SetLinked(res.Linked.RemoteID()); err != nil {
+			return err
+		}
+		if present[*res.Linked.RemoteID()] {
+			counts.present++
+			if report == nil {
+				if err := items[i].SetLiked(); err != nil {
+					return err
+				}
+			}
 			continue
 		}
-		toLinkedIds = append(toLinkedIds, *res.Linked.RemoteID())
-		bar.Add(1)
+		toLike = append(toLike, items[i])
 	}
-	bar.Exit()
-	slog.Info("Liking", "entitiesCount", len(toLinkedIds))
-	return toAct.Like(context.Background(), toLinkedIds)
-}
 
-func (e transfer) getAcc(id string) (shared.Account, error) {
-	acc, err := repository.AccountByID(shared.RepositoryID(id))
-	if err != nil {
-		return nil, err
+	if report != nil {
+		slog.Info("Dry run. Skip liking", "section", section.Kind(),
+			"already present", counts.present, "to add", len(toLike), "not found", counts.notFound)
+		return nil
 	}
-	if shared.IsNil(acc) {
-		slog.Error("Account not exists")
-		return nil, errors.New("account not exists")
+
+	// Oldest first, so target keeps likes chronology.
+	sort.SliceStable(toLike, func(i, j int) bool {
+		return likedBefore(fromAct.LikedAt(toLike[i].SourceID()), fromAct.LikedAt(toLike[j].SourceID()))
+	})
+
+	slog.Info("Liking", "entitiesCount", len(toLike))
+	for _, chunk := range shared.ChunkSlice(toLike, transferLikeChunkSize) {
+		// Different source entities can be linked with same target.
+		ids := make([]shared.RemoteID, 0, len(chunk))
+		for i := range chunk {
+			id := *chunk[i].TargetID()
+			if !present[id] {
+				present[id] = true
+				ids = append(ids, id)
+			}
+		}
+		var err error
+		if len(ids) > 0 {
+			err = e.try(ctx, func() error {
+				return toAct.Like(ctx, ids)
+			})
+		}
+		if err != nil {
+			for _, id := range ids {
+				delete(present, id)
+			}
+			if err := e.itemsFailed(section, chunk, err); err != nil {
+				return err
+			}
+			hasFailed = true
+			continue
+		}
+		if err := section.AddChanges(ctx, repository.TransferChangeAdded, ids); err != nil {
+			return err
+		}
+		for i := range chunk {
+			if err := chunk[i].SetLiked(); err != nil {
+				return err
+			}
+		}
+		counts.added += len(chunk)
 	}
-	return acc, err
+
+	slog.Info("Transferred", "section", section.Kind(),
+		"already present", counts.present, "added", counts.added, "not found", counts.notFound)
+
+	if hasFailed {
+		// Failed items will be retried on resume.
+		return nil
+	}
+
+	return section.SetDone(true)
 }
 
-type playlistLikedActions struct {
-	pl shared.RemotePlaylist
+// Source entities by result.
+type transferCounts struct {
+	present, added, notFound int
 }
 
-func (e playlistLikedActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
-	trs, err := e.pl.Tracks(ctx)
+// Get liked in target and link them,
+// so source entities will be linked with already liked versions.
+func (e transfer) targetLiked(
+	ctx context.Context,
+	lnk *linker.Static,
+	pool linker.Pool,
+	source shared.RemoteName,
+	act shared.LikedActions,
+) (map[shared.RemoteID]bool, error) {
+	slog.Info("Getting liked from target...")
+	liked, err := act.Liked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bar := progressbar.Default(int64(len(liked)))
+	bar.Describe("Linking target (Remote -> DB)")
+	err = pool.Run(ctx, len(liked), func(ctx context.Context, i int) error {
+		defer bar.Add(1)
+		err := e.try(ctx, func() error {
+			_, err := lnk.FromRemote(ctx, liked[i], source)
+			return err
+		})
+		if err != nil {
+			// Not required for transfer.
+			slog.Warn("Target entity not linked", "Name", liked[i].Name(), "ID", liked[i].ID().String(), "error", err.Error())
+		}
+		return nil
+	})
+	bar.Exit()
 	if err != nil {
 		return nil, err
 	}
-	ents := make([]shared.RemoteEntity, len(trs))
-	for i := range trs {
-		ents[i] = trs[i]
+
+	ids := make(map[shared.RemoteID]bool, len(liked))
+	for _, ent := range liked {
+		ids[ent.ID()] = true
+	}
+	return ids, err
+}
+
+// Unknown date is before any date.
+func likedBefore(a, b *time.Time) bool {
+	if a == nil {
+		return b != nil
 	}
-	return ents, err
+	return b != nil && a.Before(*b)
 }
 
-func (e playlistLikedActions) Like(ctx context.Context, ids []shared.RemoteID) error {
-	return e.pl.AddTracks(ctx, ids)
+// Unknown (linked before scores was saved), exact and manual matches are never low.
+func (e transfer) isLowScore(match linker.MatchResult) bool {
+	switch match.Method {
+	case "", linker.MatchMethodExact, linker.MatchMethodManual:
+		return false
+	}
+	return match.Score < e.minScore
 }
 
-func (e playlistLikedActions) Unlike(ctx context.Context, ids []shared.RemoteID) error {
-	return e.pl.RemoveTracks(ctx, ids)
+func (e transfer) getAcc(id string) (shared.Account, error) {
+	acc, err := repository.AccountByID(shared.RepositoryID(id))
+	if err != nil {
+		return nil, err
+	}
+	if shared.IsNil(acc) {
+		slog.Error("Account not exists")
+		return nil, errors.New("account not exists")
+	}
+	return acc, err
 }
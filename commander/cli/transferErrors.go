@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+)
+
+// What to do when entity transfer fails.
+const (
+	// Stop transfer.
+	transferOnErrorAbort = "abort"
+
+	// Mark entity as failed and continue.
+	transferOnErrorSkip = "skip"
+
+	// Retry, then skip.
+	transferOnErrorRetry = "retry"
+)
+
+// First retry delay. Doubles after each retry.
+const transferRetryDelay = 2 * time.Second
+
+func checkTransferOnError(val string) error {
+	switch val {
+	case transferOnErrorAbort, transferOnErrorSkip, transferOnErrorRetry:
+		return nil
+	}
+	return fmt.Errorf("unknown error policy: %s (expected: abort, skip, retry)", val)
+}
+
+type transferFailure struct {
+	section string
+	name    string
+	err     error
+}
+
+// Failed entities, grouped by error kind.
+type transferFailures struct {
+	byKind map[shared.ErrorKind][]transferFailure
+}
+
+func newTransferFailures() *transferFailures {
+	return &transferFailures{
+		byKind: map[shared.ErrorKind][]transferFailure{},
+	}
+}
+
+func (e *transferFailures) add(section, name string, err error) {
+	kind := shared.ErrorKindOf(err)
+	e.byKind[kind] = append(e.byKind[kind], transferFailure{
+		section: section,
+		name:    name,
+		err:     err,
+	})
+}
+
+func (e transferFailures) count() int {
+	count := 0
+	for _, failures := range e.byKind {
+		count += len(failures)
+	}
+	return count
+}
+
+func (e transferFailures) print() {
+	if e.count() == 0 {
+		return
+	}
+
+	kinds := make([]shared.ErrorKind, 0, len(e.byKind))
+	for kind := range e.byKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i] < kinds[j]
+	})
+
+	fmt.Printf("\nFailed: %d\n", e.count())
+	for _, kind := range kinds {
+		failures := e.byKind[kind]
+		fmt.Printf("%s (%d):\n", kind.String(), len(failures))
+		for _, fail := range failures {
+			fmt.Printf("  [%s] %s: %s\n", fail.section, fail.name, fail.err.Error())
+		}
+	}
+}
+
+// Call fn according to error policy.
+//
+// Returns nil if fn succeeded, or error if it failed (after all retries or ctx done).
+func (e transfer) try(ctx context.Context, fn func() error) error {
+	err := fn()
+	if err == nil || e.onError != transferOnErrorRetry {
+		return err
+	}
+
+	delay := transferRetryDelay
+	for i := 0; i < e.retries; i++ {
+		switch shared.ErrorKindOf(err) {
+		case shared.ErrorKindAuth, shared.ErrorKindNotFound:
+			// Retry will not help.
+			return err
+		}
+		slog.Warn("Retrying", "attempt", i+1, "after", delay.String(), "error", err.Error())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// Save items error and return nil if policy allows to continue.
+func (e transfer) itemsFailed(section *repository.TransferSection, items []*repository.TransferItem, err error) error {
+	for _, item := range items {
+		if errSave := e.saveFailed(section, item, err); errSave != nil {
+			return errSave
+		}
+	}
+	return e.stopOnError(err)
+}
+
+// Save errors of concurrent calls (in items order).
+//
+// RunErr: error returned by linker.Pool.
+//
+// Returns true if some items failed,
+// and error if policy not allows to continue.
+func (e transfer) failedInOrder(section *repository.TransferSection, items []*repository.TransferItem, errs []error, runErr error) (bool, error) {
+	hasFailed := false
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if runErr != nil && runErr != err && errors.Is(err, context.Canceled) {
+			// Canceled by abort, not failed.
+			continue
+		}
+		hasFailed = true
+		if errSave := e.saveFailed(section, items[i], err); errSave != nil {
+			return hasFailed, errSave
+		}
+	}
+	return hasFailed, runErr
+}
+
+func (e transfer) saveFailed(section *repository.TransferSection, item *repository.TransferItem, err error) error {
+	if errSave := item.SetFailed(err); errSave != nil {
+		return errSave
+	}
+	e.failures.add(section.Kind(), item.Name(), err)
+	if e.onError != transferOnErrorAbort {
+		slog.Error("Skip", "Name", item.Name(), "ID", item.SourceID().String(), "error", err.Error())
+	}
+	return nil
+}
+
+// Error if policy not allows to continue.
+func (e transfer) stopOnError(err error) error {
+	if e.onError == transferOnErrorAbort {
+		return err
+	}
+	return nil
+}
+
+var errTransferHasFailures = errors.New("some entities not transferred")
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+)
+
+var errTransferMirrorCanceled = errors.New("mirror canceled")
+
+// Unlike entities in target, that not liked in source.
+//
+// Must be called after section transferred.
+func (e transfer) mirror(section *repository.TransferSection, toAct shared.LikedActions, dryRun bool) error {
+	ctx := context.Background()
+
+	items, err := section.Items(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Target IDs of source entities.
+	wanted := make(map[shared.RemoteID]bool, len(items))
+	for _, item := range items {
+		switch item.Status() {
+		case repository.TransferItemStatusPending, repository.TransferItemStatusFailed:
+			// Entity can be liked in target, but not linked yet.
+			slog.Warn("Some entities not transferred. Skip mirror", "section", section.Kind())
+			return nil
+		case repository.TransferItemStatusLinked, repository.TransferItemStatusLiked:
+			if item.TargetID() != nil {
+				wanted[*item.TargetID()] = true
+			}
+		}
+	}
+
+	liked, err := toAct.Liked(ctx)
+	if err != nil {
+		return err
+	}
+	extra := []shared.RemoteEntity{}
+	for _, ent := range liked {
+		if !wanted[ent.ID()] {
+			extra = append(extra, ent)
+		}
+	}
+	if len(extra) == 0 {
+		slog.Info("Mirror: nothing to remove", "section", section.Kind())
+		return nil
+	}
+
+	fmt.Printf("\nMirror (%s). Will be removed from target: %d\n", section.Kind(), len(extra))
+	for _, ent := range extra {
+		fmt.Printf("  %s (%s)\n", ent.Name(), ent.ID())
+	}
+	if dryRun {
+		slog.Info("Dry run. Skip removing")
+		return nil
+	}
+
+	fmt.Print("Type \"yes\" to remove: ")
+	answer, err := readInput()
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(answer) != "yes" {
+		return errTransferMirrorCanceled
+	}
+
+	ids := make([]shared.RemoteID, len(extra))
+	for i := range extra {
+		ids[i] = extra[i].ID()
+	}
+	for _, chunk := range shared.ChunkSlice(ids, transferLikeChunkSize) {
+		if err := toAct.Unlike(ctx, chunk); err != nil {
+			return err
+		}
+		if err := section.AddChanges(ctx, repository.TransferChangeRemoved, chunk); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("Mirror: removed", "section", section.Kind(), "count", len(ids))
+	return nil
+}
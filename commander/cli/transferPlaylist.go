@@ -0,0 +1,417 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+)
+
+func (e transfer) transferPlaylists(
+	lnk *linker.Static,
+	job *repository.TransferJob,
+	fromAcc shared.Account, toAcc shared.Account,
+	fromAct shared.PlaylistActions, toAct shared.PlaylistActions,
+	report *transferReport,
+) error {
+	rCtx := context.Background()
+
+	fromPlaylists, err := fromAct.MyPlaylists(rCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, fromPlaylist := range fromPlaylists {
+		slog.Info("Current playlist", "Name", fromPlaylist.Name())
+
+		section, err := job.Section(transferSectionPlaylist, fromPlaylist.ID())
+		if err != nil {
+			return err
+		}
+
+		fromWrapAct := &playlistLikedActions{pl: fromPlaylist}
+
+		if report != nil {
+			if err := e.transferBtw(lnk, section, fromAcc, toAcc, fromWrapAct, nil, report.section("playlist: "+fromPlaylist.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if section.Done() {
+			slog.Info("Already transferred", "Name", fromPlaylist.Name())
+			continue
+		}
+
+		toPlaylist, entityID, created, err := e.targetPlaylist(rCtx, section, fromAcc, toAcc, fromPlaylist, toAct)
+		if err != nil {
+			return err
+		}
+
+		var synced *repository.SyncedPlaylist
+		if !created {
+			if synced, err = repository.SyncedPlaylistByEntity(entityID); err != nil {
+				return err
+			}
+		}
+
+		fromTracks, err := fromWrapAct.Liked(rCtx)
+		if err != nil {
+			return err
+		}
+		toTracks, err := toPlaylist.Tracks(rCtx)
+		if err != nil {
+			return err
+		}
+		toWrapAct := &playlistLikedActions{pl: toPlaylist}
+		toWrapAct.setExisting(toTracks)
+
+		if synced != nil {
+			if err := e.syncPlaylistInfo(rCtx, synced, fromPlaylist, toPlaylist); err != nil {
+				return err
+			}
+			if err := e.removePlaylistTracks(rCtx, section, synced, fromAcc, toAcc, fromTracks, toWrapAct); err != nil {
+				return err
+			}
+		}
+
+		if err := e.transferBtw(lnk, section, fromAcc, toAcc, fromWrapAct, toWrapAct, nil); err != nil {
+			return err
+		}
+		if !section.Done() {
+			// Some tracks failed. Will be synced on resume.
+			continue
+		}
+		if err := e.orderPlaylist(rCtx, section, fromTracks, toPlaylist); err != nil {
+			return err
+		}
+
+		fromIDs := make([]shared.RemoteID, len(fromTracks))
+		for i := range fromTracks {
+			fromIDs[i] = fromTracks[i].ID()
+		}
+		var isVisible *bool
+		if vis, err := fromPlaylist.IsVisible(); err == nil {
+			isVisible = &vis
+		}
+		if err := repository.SaveSyncedPlaylist(rCtx, entityID, fromPlaylist, isVisible, fromIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get playlist linked with source playlist, or create new and link it.
+//
+// Created: true if playlist created now.
+func (e transfer) targetPlaylist(
+	ctx context.Context,
+	section *repository.TransferSection,
+	fromAcc shared.Account, toAcc shared.Account,
+	fromPlaylist shared.RemotePlaylist,
+	toAct shared.PlaylistActions,
+) (toPlaylist shared.RemotePlaylist, entityID shared.EntityID, created bool, err error) {
+	fromLinkables := repository.NewLinkablePlaylist(fromAcc.ID())
+	toLinkables := repository.NewLinkablePlaylist(toAcc.ID())
+
+	fromLinked, err := fromLinkables.LinkedRemoteID(ctx, fromPlaylist.ID())
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	var toLinked linker.Linked
+	if shared.IsNil(fromLinked) {
+		err = repository.Transaction(ctx, func(ctx context.Context) error {
+			if entityID, err = repository.PlaylistEntity.CreateEntity(ctx); err != nil {
+				return err
+			}
+			fromID := fromPlaylist.ID()
+			_, err = fromLinkables.CreateLink(ctx, entityID, &fromID, linker.MatchResult{})
+			return err
+		})
+		if err != nil {
+			return nil, "", false, err
+		}
+	} else {
+		entityID = fromLinked.EntityID()
+		if toLinked, err = toLinkables.LinkedEntity(ctx, entityID); err != nil {
+			return nil, "", false, err
+		}
+	}
+
+	// Linked before?
+	if !shared.IsNil(toLinked) && toLinked.RemoteID() != nil {
+		toPlaylist, err = toAct.Playlist(ctx, *toLinked.RemoteID())
+		if err != nil {
+			return nil, "", false, err
+		}
+		if !shared.IsNil(toPlaylist) {
+			return toPlaylist, entityID, false, section.SetTargetID(toLinked.RemoteID())
+		}
+		slog.Warn("Linked playlist not found in target. Creating new", "Name", fromPlaylist.Name())
+	}
+
+	isVis, _ := fromPlaylist.IsVisible()
+
+	toPlaylist, err = toAct.Create(ctx, fromPlaylist.Name(), isVis, fromPlaylist.Description())
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	toID := toPlaylist.ID()
+	if err = section.AddChanges(ctx, repository.TransferChangeCreated, []shared.RemoteID{toID}); err != nil {
+		return nil, "", false, err
+	}
+	if shared.IsNil(toLinked) {
+		_, err = toLinkables.CreateLink(ctx, entityID, &toID, linker.MatchResult{})
+	} else {
+		err = toLinked.SetRemoteID(ctx, &toID, linker.MatchResult{})
+	}
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return toPlaylist, entityID, true, section.SetTargetID(&toID)
+}
+
+// Rename, set description and visibility, if changed in source since last sync.
+func (e transfer) syncPlaylistInfo(ctx context.Context, synced *repository.SyncedPlaylist, from, to shared.RemotePlaylist) error {
+	if from.Name() != synced.Name() {
+		slog.Info("Rename playlist", "from", synced.Name(), "to", from.Name())
+		if err := to.Rename(ctx, from.Name()); err != nil {
+			return err
+		}
+	}
+
+	// Nil description: not supported by remote.
+	desc := from.Description()
+	if desc != nil && to.Description() != nil &&
+		(synced.Description() == nil || *synced.Description() != *desc) {
+		slog.Info("Set playlist description", "Name", from.Name())
+		if err := to.SetDescription(ctx, *desc); err != nil {
+			return err
+		}
+	}
+
+	// Error: visibility not supported by remote.
+	isVis, err := from.IsVisible()
+	if err == nil && synced.IsVisible() != nil && *synced.IsVisible() != isVis {
+		slog.Info("Set playlist visibility", "Name", from.Name(), "visible", isVis)
+		if err := to.SetIsVisible(ctx, isVis); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remove tracks from target, that removed from source since last sync.
+func (e transfer) removePlaylistTracks(
+	ctx context.Context,
+	section *repository.TransferSection,
+	synced *repository.SyncedPlaylist,
+	fromAcc shared.Account, toAcc shared.Account,
+	fromTracks []shared.RemoteEntity,
+	toAct *playlistLikedActions,
+) error {
+	syncedIDs, err := synced.Tracks(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[shared.RemoteID]bool, len(fromTracks))
+	for _, track := range fromTracks {
+		current[track.ID()] = true
+	}
+
+	fromLinkables := repository.NewLinkableEntity(repository.EntityNameTrack, fromAcc.RemoteName())
+	toLinkables := repository.NewLinkableEntity(repository.EntityNameTrack, toAcc.RemoteName())
+
+	toRemove := []shared.RemoteID{}
+	for _, id := range syncedIDs {
+		if current[id] {
+			continue
+		}
+		fromLinked, err := fromLinkables.LinkedRemoteID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if shared.IsNil(fromLinked) {
+			continue
+		}
+		toLinked, err := toLinkables.LinkedEntity(ctx, fromLinked.EntityID())
+		if err != nil {
+			return err
+		}
+		if shared.IsNil(toLinked) || toLinked.RemoteID() == nil {
+			continue
+		}
+		if toAct.existing[*toLinked.RemoteID()] {
+			toRemove = append(toRemove, *toLinked.RemoteID())
+		}
+	}
+
+	if len(toRemove) == 0 {
+		return nil
+	}
+	slog.Info("Removing tracks from playlist", "count", len(toRemove))
+	if err := toAct.Unlike(ctx, toRemove); err != nil {
+		return err
+	}
+	return section.AddChanges(ctx, repository.TransferChangeRemoved, toRemove)
+}
+
+// Reorder target tracks to match source.
+//
+// Target tracks that not in source are moved to the end.
+func (e transfer) orderPlaylist(
+	ctx context.Context,
+	section *repository.TransferSection,
+	fromTracks []shared.RemoteEntity,
+	to shared.RemotePlaylist,
+) error {
+	items, err := section.SyncItems(ctx, fromTracks)
+	if err != nil {
+		return err
+	}
+	toTracks, err := to.Tracks(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make([]shared.RemoteID, 0, len(toTracks))
+	inTarget := make(map[shared.RemoteID]bool, len(toTracks))
+	for _, track := range toTracks {
+		if inTarget[track.ID()] {
+			// Reorder can remove duplicates.
+			slog.Warn("Playlist has duplicates. Skip reorder", "Name", to.Name())
+			return nil
+		}
+		inTarget[track.ID()] = true
+		current = append(current, track.ID())
+	}
+
+	wanted := make([]shared.RemoteID, 0, len(current))
+	added := make(map[shared.RemoteID]bool, len(current))
+	for _, item := range items {
+		id := item.TargetID()
+		if id == nil || !inTarget[*id] || added[*id] {
+			continue
+		}
+		added[*id] = true
+		wanted = append(wanted, *id)
+	}
+	for _, id := range current {
+		if !added[id] {
+			wanted = append(wanted, id)
+		}
+	}
+
+	ordered := true
+	for i := range current {
+		if current[i] != wanted[i] {
+			ordered = false
+			break
+		}
+	}
+	if ordered {
+		return nil
+	}
+
+	notImplemented := false
+	err = e.try(ctx, func() error {
+		err := to.Reorder(ctx, wanted)
+		if errors.Is(err, shared.ErrNotImplemented) {
+			notImplemented = true
+			return nil
+		}
+		return err
+	})
+	if notImplemented {
+		slog.Info("Remote can't reorder tracks. Tracks added in source order", "Name", to.Name())
+		return nil
+	}
+	if err != nil {
+		e.failures.add(section.Kind(), to.Name()+" (order)", err)
+		slog.Error("Reorder", "Name", to.Name(), "error", err.Error())
+		return e.stopOnError(err)
+	}
+
+	return nil
+}
+
+// Playlist as liked tracks.
+type playlistLikedActions struct {
+	pl shared.RemotePlaylist
+
+	// Tracks from first Liked call.
+	tracks []shared.RemoteEntity
+
+	// Tracks in playlist. Like skips them, so tracks not duplicated.
+	existing map[shared.RemoteID]bool
+}
+
+func (e *playlistLikedActions) setExisting(tracks []shared.RemoteTrack) {
+	e.existing = make(map[shared.RemoteID]bool, len(tracks))
+	for _, track := range tracks {
+		e.existing[track.ID()] = true
+	}
+}
+
+func (e *playlistLikedActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+	if e.tracks != nil {
+		return e.tracks, nil
+	}
+	trs, err := e.pl.Tracks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ents := make([]shared.RemoteEntity, len(trs))
+	for i := range trs {
+		ents[i] = trs[i]
+	}
+	e.tracks = ents
+	return ents, err
+}
+
+// Playlist order is kept by Reorder.
+func (e *playlistLikedActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
+func (e *playlistLikedActions) Like(ctx context.Context, ids []shared.RemoteID) error {
+	toAdd := make([]shared.RemoteID, 0, len(ids))
+	for _, id := range ids {
+		if !e.existing[id] {
+			toAdd = append(toAdd, id)
+		}
+	}
+	if len(toAdd) == 0 {
+		return nil
+	}
+	if err := e.pl.AddTracks(ctx, toAdd); err != nil {
+		return err
+	}
+	if e.existing == nil {
+		e.existing = map[shared.RemoteID]bool{}
+	}
+	for _, id := range toAdd {
+		e.existing[id] = true
+	}
+	return nil
+}
+
+func (e *playlistLikedActions) Unlike(ctx context.Context, ids []shared.RemoteID) error {
+	if err := e.pl.RemoveTracks(ctx, ids); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		delete(e.existing, id)
+	}
+	return nil
+}
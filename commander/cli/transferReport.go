@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"encoding/csv"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/shared"
+)
+
+// Match report of dry run transfer.
+func newTransferReport(toAcc shared.Account) *transferReport {
+	return &transferReport{
+		toAcc: toAcc,
+	}
+}
+
+type transferReport struct {
+	toAcc shared.Account
+	rows  [][]string
+}
+
+// Example: section("liked tracks").
+//
+// Nil if report is nil.
+func (e *transferReport) section(what string) *transferReportSection {
+	if e == nil {
+		return nil
+	}
+	return &transferReportSection{
+		report: e,
+		what:   what,
+	}
+}
+
+// Write CSV to path. If path empty, writes to stdout.
+func (e transferReport) write(path string) error {
+	var out io.Writer = os.Stdout
+	if len(path) > 0 {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	writer := csv.NewWriter(out)
+	header := []string{
+		"what",
+		"source remote", "source id", "name",
+		"target remote", "target id",
+		"score", "method",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(e.rows); err != nil {
+		return err
+	}
+
+	if len(path) > 0 {
+		slog.Info("Report saved", "path", path, "rows", len(e.rows))
+	}
+	return nil
+}
+
+type transferReportSection struct {
+	report *transferReport
+	what   string
+}
+
+// Add source entity. Target ID is nil if not found.
+//
+// Does nothing if section is nil.
+func (e *transferReportSection) add(source shared.RemoteEntity, targetID *shared.RemoteID, match linker.MatchResult) {
+	if e == nil {
+		return
+	}
+
+	var target string
+	if targetID != nil {
+		target = targetID.String()
+	}
+
+	var score string
+	if len(match.Method) > 0 {
+		score = strconv.FormatFloat(match.Score, 'f', 2, 64)
+	}
+
+	e.report.rows = append(e.report.rows, []string{
+		e.what,
+		source.RemoteName().String(), source.ID().String(), source.Name(),
+		e.report.toAcc.RemoteName().String(), target,
+		score, match.Method.String(),
+	})
+}
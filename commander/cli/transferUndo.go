@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+	"github.com/urfave/cli/v2"
+)
+
+func (e transfer) undo() *cli.Command {
+	return &cli.Command{
+		Name:      "undo",
+		Usage:     "Revert changes made in target by transfer job: likes, created playlists, added tracks and mirror removals",
+		ArgsUsage: "<jobID>",
+		Action: func(ctx *cli.Context) error {
+			jobID := ctx.Args().First()
+			if len(jobID) == 0 {
+				return errors.New("job id required")
+			}
+			job, err := repository.TransferJobByID(shared.RepositoryID(jobID))
+			if err != nil {
+				return err
+			}
+			if job == nil {
+				return errors.New("transfer job not exists")
+			}
+			if job.Status() == repository.TransferJobStatusUndone {
+				return errors.New("transfer job already undone")
+			}
+			toAcc, err := e.getAcc(job.ToAccountID().String())
+			if err != nil {
+				return err
+			}
+			if err := e.undoJob(job, toAcc); err != nil {
+				return err
+			}
+			slog.Info("Done")
+			return nil
+		},
+	}
+}
+
+func (e transfer) undoJob(job *repository.TransferJob, toAcc shared.Account) error {
+	ctx := context.Background()
+
+	acts, err := toAcc.Actions()
+	if err != nil {
+		return err
+	}
+
+	sections, err := job.Sections(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range sections {
+		if section.Kind() == transferSectionPlaylist {
+			err = e.undoPlaylist(ctx, section, acts.Playlist())
+		} else {
+			err = e.undoLiked(ctx, section, acts)
+		}
+		if err != nil {
+			return err
+		}
+		if err := section.DeleteChanges(ctx); err != nil {
+			return err
+		}
+	}
+
+	return job.SetStatus(repository.TransferJobStatusUndone)
+}
+
+func (e transfer) undoLiked(ctx context.Context, section *repository.TransferSection, acts shared.AccountActions) error {
+	var act shared.LikedActions
+	switch section.Kind() {
+	case transferSectionLikedAlbums:
+		act = acts.LikedAlbums()
+	case transferSectionLikedArtists:
+		act = acts.LikedArtists()
+	case transferSectionLikedTracks:
+		act = acts.LikedTracks()
+	default:
+		slog.Warn("Unknown section. Skip", "kind", section.Kind())
+		return nil
+	}
+
+	ids, err := section.Changes(ctx, repository.TransferChangeAdded)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		slog.Info("Unliking", "section", section.Kind(), "count", len(ids))
+	}
+	for _, chunk := range shared.ChunkSlice(ids, transferLikeChunkSize) {
+		if err := act.Unlike(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	// Unliked by mirror.
+	if ids, err = section.Changes(ctx, repository.TransferChangeRemoved); err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		slog.Info("Liking removed", "section", section.Kind(), "count", len(ids))
+	}
+	for _, chunk := range shared.ChunkSlice(ids, transferLikeChunkSize) {
+		if err := act.Like(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e transfer) undoPlaylist(ctx context.Context, section *repository.TransferSection, act shared.PlaylistActions) error {
+	created, err := e.createdPlaylists(ctx, section, act)
+	if err != nil {
+		return err
+	}
+	if len(created) > 0 {
+		slog.Info("Deleting created playlists", "count", len(created))
+		if err := act.Delete(ctx, created); err != nil {
+			return err
+		}
+	}
+
+	target := section.TargetID()
+	if target == nil {
+		return nil
+	}
+	for _, id := range created {
+		if id == *target {
+			// Tracks deleted with playlist.
+			return nil
+		}
+	}
+
+	added, err := section.Changes(ctx, repository.TransferChangeAdded)
+	if err != nil {
+		return err
+	}
+	removed, err := section.Changes(ctx, repository.TransferChangeRemoved)
+	if err != nil {
+		return err
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	playlist, err := act.Playlist(ctx, *target)
+	if err != nil {
+		return err
+	}
+	if shared.IsNil(playlist) {
+		slog.Warn("Playlist not found in target. Skip", "ID", target.String())
+		return nil
+	}
+
+	if len(added) > 0 {
+		slog.Info("Removing tracks from playlist", "Name", playlist.Name(), "count", len(added))
+		if err := playlist.RemoveTracks(ctx, added); err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		slog.Info("Restoring removed tracks", "Name", playlist.Name(), "count", len(removed))
+		if err := playlist.AddTracks(ctx, removed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Playlists created by transfer, that still exists in target.
+func (e transfer) createdPlaylists(ctx context.Context, section *repository.TransferSection, act shared.PlaylistActions) ([]shared.RemoteID, error) {
+	ids, err := section.Changes(ctx, repository.TransferChangeCreated)
+	if err != nil {
+		return nil, err
+	}
+	existing := make([]shared.RemoteID, 0, len(ids))
+	for _, id := range ids {
+		playlist, err := act.Playlist(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if shared.IsNil(playlist) {
+			continue
+		}
+		existing = append(existing, id)
+	}
+	return existing, err
+}
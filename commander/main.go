@@ -10,7 +10,9 @@ import (
 	"github.com/oklookat/synchro/linking/linkerimpl"
 	"github.com/oklookat/synchro/logger"
 	"github.com/oklookat/synchro/remote/deezer"
+	"github.com/oklookat/synchro/remote/localfiles"
 	"github.com/oklookat/synchro/remote/spotify"
+	"github.com/oklookat/synchro/remote/spotifyexport"
 	"github.com/oklookat/synchro/remote/vkmusic"
 	"github.com/oklookat/synchro/remote/yandexmusic"
 	"github.com/oklookat/synchro/remote/zvuk"
@@ -20,11 +22,13 @@ import (
 
 var (
 	_remotes = map[shared.RemoteName]shared.Remote{
-		yandexmusic.RemoteName: &yandexmusic.Remote{},
-		spotify.RemoteName:     &spotify.Remote{},
-		zvuk.RemoteName:        &zvuk.Remote{},
-		vkmusic.RemoteName:     &vkmusic.Remote{},
-		deezer.RemoteName:      &deezer.Remote{},
+		yandexmusic.RemoteName:   &yandexmusic.Remote{},
+		spotify.RemoteName:       &spotify.Remote{},
+		zvuk.RemoteName:          &zvuk.Remote{},
+		vkmusic.RemoteName:       &vkmusic.Remote{},
+		deezer.RemoteName:        &deezer.Remote{},
+		localfiles.RemoteName:    &localfiles.Remote{},
+		spotifyexport.RemoteName: &spotifyexport.Remote{},
 	}
 )
 
@@ -1,14 +1,28 @@
 package config
 
+import "fmt"
+
 type Linker struct {
 	// Recheck missing entities?
 	RecheckMissing bool `json:"recheckMissing"`
+
+	// How long cached entities metadata used instead of API requests (hours).
+	//
+	// Used by rechecks. Entities deleted from remote detected after cache expires.
+	// Existence checks (like manual linking) always request remote.
+	//
+	// 0 - don't use cache (default).
+	MetadataTTLHours int `json:"metadataTTLHours"`
 }
 
 func (c *Linker) Default() {
 	c.RecheckMissing = false
+	c.MetadataTTLHours = 0
 }
 
 func (c Linker) Validate() error {
+	if c.MetadataTTLHours < 0 {
+		return fmt.Errorf("negative metadata TTL: %d", c.MetadataTTLHours)
+	}
 	return nil
 }
@@ -13,6 +13,7 @@ const (
 	KeyGeneral     Key = "general"
 	KeyLinker      Key = "linker"
 	KeySpotify     Key = "spotify"
+	KeySync        Key = "sync"
 	KeyVKMusic     Key = "vkMusic"
 	KeyYandexMusic Key = "yandexMusic"
 	KeyZvuk        Key = "zvuk"
@@ -25,6 +26,7 @@ var (
 		KeyGeneral:     &General{},
 		KeyLinker:      &Linker{},
 		KeySpotify:     &Spotify{},
+		KeySync:        &Sync{},
 		KeyVKMusic:     &VKMusic{},
 		KeyYandexMusic: &YandexMusic{},
 		KeyZvuk:        &Zvuk{},
@@ -0,0 +1,33 @@
+package config
+
+import "fmt"
+
+// What to do if entity liked in one account, and unliked in another (since last sync).
+type SyncConflict string
+
+const (
+	// Like in all accounts.
+	SyncConflictLike SyncConflict = "like"
+
+	// Unlike in all accounts.
+	SyncConflictUnlike SyncConflict = "unlike"
+
+	// Don't touch.
+	SyncConflictSkip SyncConflict = "skip"
+)
+
+type Sync struct {
+	Conflict SyncConflict `json:"conflict"`
+}
+
+func (c *Sync) Default() {
+	c.Conflict = SyncConflictLike
+}
+
+func (c Sync) Validate() error {
+	switch c.Conflict {
+	case SyncConflictLike, SyncConflictUnlike, SyncConflictSkip:
+		return nil
+	}
+	return fmt.Errorf("unknown sync conflict rule: %s", c.Conflict)
+}
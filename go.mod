@@ -4,6 +4,7 @@ go 1.22
 
 require (
 	github.com/adrg/strutil v0.3.1
+	github.com/dhowden/tag v0.0.0-20240417053706-3d75831295e8
 	github.com/gofiber/fiber/v3 v3.0.0-beta.3
 	github.com/gosimple/slug v1.14.0
 	github.com/jmoiron/sqlx v1.4.0
@@ -22,8 +23,11 @@ require (
 	github.com/urfave/cli/v2 v2.27.2
 	github.com/vitali-fedulov/images4 v1.3.1
 	github.com/zmb3/spotify/v2 v2.4.2
+	golang.org/x/crypto v0.24.0
 	golang.org/x/oauth2 v0.21.0
+	golang.org/x/term v0.22.0
 	golang.org/x/text v0.16.0
+	golang.org/x/time v0.5.0
 )
 
 require (
@@ -52,6 +56,4 @@ require (
 	github.com/valyala/tcplisten v1.0.0 // indirect
 	github.com/xrash/smetrics v0.0.0-20240521201337-686a1a2994c1 // indirect
 	golang.org/x/sys v0.22.0 // indirect
-	golang.org/x/term v0.22.0 // indirect
-	golang.org/x/time v0.5.0 // indirect
 )
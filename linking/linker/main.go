@@ -1,6 +1,8 @@
 package linker
 
 import (
+	"context"
+
 	"github.com/oklookat/synchro/shared"
 )
 
@@ -9,19 +11,35 @@ type (
 	//
 	// Example: artist repository.
 	Repository interface {
-		CreateEntity() (shared.EntityID, error)
+		// Unit of work. All DB calls with ctx passed to fn committed together, or not at all.
+		Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+		CreateEntity(context.Context) (shared.EntityID, error)
 
 		// 1. Delete entities that not linked with any remote.
 		//
 		// 2. Delete entities that linked, but have NULL RemoteID on all remotes.
-		DeleteNotLinked() error
+		DeleteNotLinked(context.Context) error
 
 		// Delete all entities.
-		DeleteAll() error
+		DeleteAll(context.Context) error
+
+		// Add ambiguous match to review queue.
+		//
+		// Replaces previous review for same entity and target.
+		AddReview(ctx context.Context, entityID shared.EntityID, source RemoteEntity, target shared.RemoteName, candidates []MatchCandidate) error
 	}
 
 	// Can import/export links.
 	RepositoryShareable interface {
 		Repository
+
+		// All entities with links. Links on remote accounts (playlists) not included.
+		Export(context.Context) ([]SharedEntity, error)
+
+		// Import entities. Entity found by any of its remote IDs, or created.
+		//
+		// Links of unknown remotes skipped.
+		Import(context.Context, []SharedEntity, ConflictRule) (ShareImportResult, error)
 	}
 )
@@ -0,0 +1,105 @@
+package linker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/oklookat/synchro/shared"
+	"golang.org/x/time/rate"
+)
+
+func newRemoteLimiter(limits shared.RemoteLimits) *remoteLimiter {
+	parallelism := limits.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	every := rate.Inf
+	if limits.Rate > 0 {
+		every = rate.Limit(limits.Rate)
+	}
+	return &remoteLimiter{
+		slots: make(chan struct{}, parallelism),
+		rate:  rate.NewLimiter(every, 1),
+	}
+}
+
+// Limits operations on remote.
+type remoteLimiter struct {
+	slots chan struct{}
+	rate  *rate.Limiter
+}
+
+// Wait for free slot. Call release after operation.
+func (e remoteLimiter) acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case e.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if err := e.rate.Wait(ctx); err != nil {
+		<-e.slots
+		return nil, err
+	}
+	return func() { <-e.slots }, nil
+}
+
+func NewPool(workers int) Pool {
+	if workers < 1 {
+		workers = 1
+	}
+	return Pool{
+		workers: workers,
+	}
+}
+
+// Runs linker operations concurrently.
+//
+// Limits per remote are applied by linker itself,
+// so pool just needs enough workers (see Static.Workers).
+type Pool struct {
+	workers int
+}
+
+// Call fn for each index from 0 to count-1.
+//
+// On first error, context of other calls will be canceled,
+// and this error will be returned.
+func (e Pool) Run(ctx context.Context, count int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := 0; i < count; i++ {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for w := 0; w < e.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := fn(ctx, i); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,175 @@
+package linker
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Shared links format version. Increment on breaking changes.
+const ShareVersion = 1
+
+var (
+	ErrUnsupportedShareVersion = errors.New("unsupported links version")
+	ErrUnknownConflictRule     = errors.New("unknown conflict rule")
+)
+
+// What to do if imported link differs from existing one.
+type ConflictRule string
+
+const (
+	// Existing link wins.
+	ConflictKeepMine ConflictRule = "mine"
+
+	// Imported link wins.
+	ConflictTakeTheirs ConflictRule = "theirs"
+
+	// Link with higher match score wins. Pinned links have highest confidence.
+	ConflictHigherScore ConflictRule = "score"
+)
+
+// Example: "theirs".
+func ParseConflictRule(val string) (ConflictRule, error) {
+	switch rule := ConflictRule(val); rule {
+	case ConflictKeepMine, ConflictTakeTheirs, ConflictHigherScore:
+		return rule, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownConflictRule, val)
+}
+
+type (
+	// Entity with links on remotes. Without repository IDs, so can be shared between databases.
+	SharedEntity struct {
+		Links []SharedLink `json:"links"`
+	}
+
+	SharedLink struct {
+		Remote shared.RemoteName `json:"remote"`
+
+		// Nil if missing on remote.
+		ID *shared.RemoteID `json:"id"`
+
+		Score      float64     `json:"score"`
+		Method     MatchMethod `json:"method,omitempty"`
+		Version    int         `json:"matcherVersion,omitempty"`
+		Pinned     bool        `json:"pinned,omitempty"`
+		ModifiedAt time.Time   `json:"modifiedAt"`
+	}
+
+	ShareImportResult struct {
+		// Links created.
+		Created,
+
+		// Existing links replaced by imported.
+		Replaced,
+
+		// Existing links kept by conflict rule.
+		Kept,
+
+		// Same as existing, or remote not exists here, or remote ID linked with other entity.
+		Skipped int
+	}
+)
+
+func (e SharedLink) Match() MatchResult {
+	return MatchResult{
+		Score:   e.Score,
+		Method:  e.Method,
+		Version: e.Version,
+	}
+}
+
+// Higher is better.
+func (e SharedLink) confidence() float64 {
+	if e.Pinned {
+		return 2
+	}
+	return e.Score
+}
+
+// Should theirs replace mine.
+func (e ConflictRule) Replace(mine, theirs SharedLink) bool {
+	switch e {
+	case ConflictTakeTheirs:
+		return true
+	case ConflictHigherScore:
+		return theirs.confidence() > mine.confidence()
+	}
+	return false
+}
+
+type ShareHeader struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Example: track entity with links.
+type ShareRecord struct {
+	Entity string `json:"entity"`
+	SharedEntity
+}
+
+// Shared links (NDJSON). Writes header first.
+func NewShareWriter(w io.Writer) (*ShareWriter, error) {
+	enc := json.NewEncoder(w)
+	header := ShareHeader{Version: ShareVersion, CreatedAt: time.Now()}
+	if err := enc.Encode(header); err != nil {
+		return nil, err
+	}
+	return &ShareWriter{enc: enc}, nil
+}
+
+type ShareWriter struct {
+	enc *json.Encoder
+}
+
+func (e ShareWriter) Write(rec ShareRecord) error {
+	return e.enc.Encode(rec)
+}
+
+// Reads header first.
+func NewShareReader(r io.Reader) (*ShareReader, ShareHeader, error) {
+	header := ShareHeader{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, header, err
+		}
+		return nil, header, io.ErrUnexpectedEOF
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, header, err
+	}
+	if header.Version < 1 || header.Version > ShareVersion {
+		return nil, header, fmt.Errorf("%w: %d", ErrUnsupportedShareVersion, header.Version)
+	}
+	return &ShareReader{scanner: scanner}, header, nil
+}
+
+type ShareReader struct {
+	scanner *bufio.Scanner
+}
+
+// Returns io.EOF after last record.
+func (e ShareReader) Next() (*ShareRecord, error) {
+	for e.scanner.Scan() {
+		if len(e.scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := &ShareRecord{}
+		if err := json.Unmarshal(e.scanner.Bytes(), rec); err != nil {
+			return nil, err
+		}
+		return rec, nil
+	}
+	if err := e.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
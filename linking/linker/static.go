@@ -3,7 +3,9 @@ package linker
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/oklookat/synchro/config"
@@ -19,25 +21,35 @@ type (
 		Name() shared.RemoteName
 
 		// Find entity from another remote in current.
-		Match(context.Context, RemoteEntity) (RemoteEntity, error)
+		//
+		// Returns nil entity if not found.
+		Match(context.Context, RemoteEntity) (RemoteEntity, MatchResult, error)
 
 		// Get entity by ID.
 		RemoteEntity(context.Context, shared.RemoteID) (RemoteEntity, error)
 
+		// Get cached entity by ID. Nil if not cached.
+		//
+		// Entity can be deleted from remote since cached, so not for existence checks.
+		CachedEntity(context.Context, shared.RemoteID) RemoteEntity
+
 		// DB ops for linked entities.
 		Linkables() Linkables
+
+		// Limits for Match and RemoteEntity calls.
+		Limits() shared.RemoteLimits
 	}
 
 	// Remote in DB.
 	Linkables interface {
 		// Link entity with remote.
-		CreateLink(context.Context, shared.EntityID, *shared.RemoteID) (Linked, error)
+		CreateLink(context.Context, shared.EntityID, *shared.RemoteID, MatchResult) (Linked, error)
 
 		// Example: get linked spotify artist by artist entity.
-		LinkedEntity(shared.EntityID) (Linked, error)
+		LinkedEntity(context.Context, shared.EntityID) (Linked, error)
 
 		// Example: get linked spotify artist by its ID on Spotify.
-		LinkedRemoteID(shared.RemoteID) (Linked, error)
+		LinkedRemoteID(context.Context, shared.RemoteID) (Linked, error)
 	}
 
 	RemoteEntity interface {
@@ -63,12 +75,48 @@ type (
 		// Example: set Spotify artist ID.
 		//
 		// Nil if not exists in remote.
-		SetRemoteID(*shared.RemoteID) error
+		SetRemoteID(context.Context, *shared.RemoteID, MatchResult) error
+
+		// How RemoteID was matched.
+		//
+		// Zero if missing or link created before matches was saved.
+		Match() MatchResult
+
+		// Link set by human. Linker never changes pinned links.
+		Pinned() bool
+
+		// Pin or unpin link.
+		SetPinned(context.Context, bool) error
 
 		// Date when link created/modified.
 		ModifiedAt() time.Time
 	}
 
+	// How entity was matched.
+	MatchResult struct {
+		// Similarity from 0 to 1.
+		Score float64
+
+		Method MatchMethod
+
+		// Version of matcher (remote Match implementation).
+		//
+		// 0 if not matched by matcher.
+		Version int
+
+		// Similar entities (best first), if the match is ambiguous.
+		//
+		// Nil if not ambiguous.
+		Candidates []MatchCandidate
+	}
+
+	// Entity from target remote that can be matched.
+	MatchCandidate struct {
+		ID    shared.RemoteID
+		Name  string
+		Score float64
+	}
+
 	ToRemoteResult struct {
 		// Missing on remote before but now present?
 		MissingBefore,
@@ -83,6 +131,9 @@ type (
 		//
 		// Can be nil.
 		Linked Linked
+
+		// Zero if target remote was not searched.
+		Match MatchResult
 	}
 
 	FromRemoteResult struct {
@@ -91,24 +142,79 @@ type (
 
 		// Linked RemoteEntity.
 		Linked Linked
+
+		// Zero if target remote was not searched.
+		Match MatchResult
 	}
 )
 
+type MatchMethod string
+
+func (e MatchMethod) String() string {
+	return string(e)
+}
+
+const (
+	// Equals by identifier like ISRC, UPC, EAN or remote ID.
+	MatchMethodExact MatchMethod = "exact"
+
+	// Similar by names, covers, length, etc.
+	MatchMethodFuzzy MatchMethod = "fuzzy"
+
+	// Only names compared. Potential mismatch.
+	MatchMethodName MatchMethod = "name"
+
+	// Linked by human.
+	MatchMethodManual MatchMethod = "manual"
+)
+
+var (
+	// Entity from source remote linked with itself.
+	matchSame = MatchResult{Score: 1, Method: MatchMethodExact}
+
+	// Entity linked by human.
+	matchManual = MatchResult{Score: 1, Method: MatchMethodManual}
+)
+
 func NewStatic(repo Repository, remotes map[shared.RemoteName]Remote) *Static {
 	slog.
 		Info("lovesYou", "linker (static)", "~~~ WISH ME LUCK! <3 ~~~")
+	limiters := make(map[shared.RemoteName]*remoteLimiter, len(remotes))
+	for name := range remotes {
+		limiters[name] = newRemoteLimiter(remotes[name].Limits())
+	}
 	return &Static{
-		repo:    repo,
-		remotes: remotes,
+		repo:     repo,
+		remotes:  remotes,
+		limiters: limiters,
+		dbMu:     &sync.Mutex{},
 	}
 }
 
 // Links global entities.
 //
 // Example: track, artist, album.
+//
+// Safe for concurrent use.
 type Static struct {
-	repo    Repository
-	remotes map[shared.RemoteName]Remote
+	repo     Repository
+	remotes  map[shared.RemoteName]Remote
+	limiters map[shared.RemoteName]*remoteLimiter
+
+	// Remote calls are made without lock,
+	// so concurrent calls not wait each other's requests.
+	dbMu *sync.Mutex
+}
+
+// Enough workers for Pool to use remotes limits.
+func (e Static) Workers(remotes ...shared.RemoteName) int {
+	workers := 0
+	for _, name := range remotes {
+		if rem, ok := e.remotes[name]; ok {
+			workers += max(rem.Limits().Parallelism, 1)
+		}
+	}
+	return workers
 }
 
 // From remote entity to linked.
@@ -124,22 +230,14 @@ func (e Static) FromRemote(ctx context.Context, source RemoteEntity, target shar
 	}
 
 	// Link exists?
-	sourceLinked, err := sourceRemote.Linkables().LinkedRemoteID(source.ID())
-	if err != nil {
-		return result, err
-	}
-
-	// Link exists.
-	if !shared.IsNil(sourceLinked) {
-		// Missing before?
-		if sourceLinked.RemoteID() == nil {
-			// Set ID.
-			slog.Info("SET ID (MISSING BEFORE)")
-			updId := source.ID()
-			if err = sourceLinked.SetRemoteID(&updId); err != nil {
-				return result, err
-			}
-		}
+	e.dbMu.Lock()
+	var sourceLinked Linked
+	err := e.repo.Transaction(ctx, func(ctx context.Context) (err error) {
+		sourceLinked, err = e.sourceLinked(ctx, sourceRemote, source)
+		return err
+	})
+	e.dbMu.Unlock()
+	if err != nil || !shared.IsNil(sourceLinked) {
 		result.Linked = sourceLinked
 		return result, err
 	}
@@ -151,17 +249,43 @@ func (e Static) FromRemote(ctx context.Context, source RemoteEntity, target shar
 		return result, shared.NewErrRemoteNotFound(target)
 	}
 
-	// Find an entity to link with target.
-	found, foundLinked, err := e.findEntityToLink(ctx, source, target)
+	// Find target.
+	slog.Info("FIND ENTITY FOR", "from", source.RemoteName().String(), "name", source.Name(), "remoteID", source.ID().String())
+	found, match, err := e.search(ctx, source, target)
 	if err != nil {
 		return result, err
 	}
+	result.Match = match
+
+	e.dbMu.Lock()
+	defer e.dbMu.Unlock()
+
+	// Entity and both links created together, so crash or cancel not leaves half links.
+	err = e.repo.Transaction(ctx, func(ctx context.Context) (err error) {
+		result.Linked, err = e.linkFound(ctx, sourceRemote, targetRemote, source, found, match)
+		return err
+	})
+	return result, err
+}
+
+// Link source with found in target. Must be called with dbMu locked, in transaction.
+func (e Static) linkFound(ctx context.Context, sourceRemote, targetRemote Remote, source, found RemoteEntity, match MatchResult) (Linked, error) {
+	// Linked while searching? (same entity in another call)
+	sourceLinked, err := e.sourceLinked(ctx, sourceRemote, source)
+	if err != nil || !shared.IsNil(sourceLinked) {
+		return sourceLinked, err
+	}
 
 	// Found id?
 	var foundIdTarget *shared.RemoteID
+	var foundLinked Linked
 	if !shared.IsNil(found) {
 		gg := found.ID()
 		foundIdTarget = &gg
+		// Target linked?
+		if foundLinked, err = targetRemote.Linkables().LinkedRemoteID(ctx, gg); err != nil {
+			return nil, err
+		}
 	}
 
 	var entityLinkTo shared.EntityID
@@ -169,14 +293,14 @@ func (e Static) FromRemote(ctx context.Context, source RemoteEntity, target shar
 	// Target not linked?
 	if shared.IsNil(foundLinked) {
 		// Create new entity.
-		entityLinkTo, err = e.repo.CreateEntity()
+		entityLinkTo, err = e.repo.CreateEntity(ctx)
 		if err != nil {
-			return result, err
+			return nil, err
 		}
 		// Link with target.
-		_, err = targetRemote.Linkables().CreateLink(context.Background(), entityLinkTo, foundIdTarget)
+		_, err = targetRemote.Linkables().CreateLink(ctx, entityLinkTo, foundIdTarget, match)
 		if err != nil {
-			return result, err
+			return nil, err
 		}
 	} else {
 		// Target linked.
@@ -185,26 +309,66 @@ func (e Static) FromRemote(ctx context.Context, source RemoteEntity, target shar
 		isIdNotChanged := ((foundLinked.RemoteID() != nil && foundIdTarget != nil) &&
 			(*foundLinked.RemoteID() == *foundIdTarget))
 		if !isIdNotChanged {
-			if err := foundLinked.SetRemoteID(foundIdTarget); err != nil {
-				return result, err
+			if err := foundLinked.SetRemoteID(ctx, foundIdTarget, match); err != nil {
+				return nil, err
 			}
 		}
 	}
 
+	if err := e.reviewIfAmbiguous(ctx, entityLinkTo, source, targetRemote.Name(), match); err != nil {
+		return nil, err
+	}
+
 	// Link with source.
 	srcId := source.ID()
-	sourceLinked, err = sourceRemote.Linkables().CreateLink(context.Background(), entityLinkTo, &srcId)
-	if err != nil {
-		return result, err
+	return sourceRemote.Linkables().CreateLink(ctx, entityLinkTo, &srcId, matchSame)
+}
+
+// Get source link, if exists. Must be called with dbMu locked.
+func (e Static) sourceLinked(ctx context.Context, sourceRemote Remote, source RemoteEntity) (Linked, error) {
+	sourceLinked, err := sourceRemote.Linkables().LinkedRemoteID(ctx, source.ID())
+	if err != nil || shared.IsNil(sourceLinked) {
+		return nil, err
 	}
 
-	result.Linked = sourceLinked
+	// Missing before?
+	if sourceLinked.RemoteID() == nil && !sourceLinked.Pinned() {
+		// Set ID.
+		slog.Info("SET ID (MISSING BEFORE)")
+		updId := source.ID()
+		if err = sourceLinked.SetRemoteID(ctx, &updId, matchSame); err != nil {
+			return nil, err
+		}
+	}
 
-	return result, err
+	return sourceLinked, err
 }
 
 // From source linked entity to target linked entity.
 func (e Static) ToRemote(ctx context.Context, sourceLinked Linked, source, target shared.RemoteName) (ToRemoteResult, error) {
+	return e.toRemote(ctx, sourceLinked, source, target, func(ctx context.Context, sourceRem Remote) (RemoteEntity, error) {
+		if cached := sourceRem.CachedEntity(ctx, *sourceLinked.RemoteID()); !shared.IsNil(cached) {
+			return cached, nil
+		}
+		return e.remoteEntity(ctx, sourceRem, *sourceLinked.RemoteID())
+	})
+}
+
+// Same as ToRemote, but source entity not requested from source remote.
+//
+// Example: entity from archive, when source account not exists anymore.
+func (e Static) ToRemoteFrom(ctx context.Context, sourceLinked Linked, source RemoteEntity, target shared.RemoteName) (ToRemoteResult, error) {
+	return e.toRemote(ctx, sourceLinked, source.RemoteName(), target, func(context.Context, Remote) (RemoteEntity, error) {
+		return source, nil
+	})
+}
+
+func (e Static) toRemote(
+	ctx context.Context,
+	sourceLinked Linked,
+	source, target shared.RemoteName,
+	sourceEntity func(context.Context, Remote) (RemoteEntity, error),
+) (ToRemoteResult, error) {
 	result := ToRemoteResult{}
 
 	// No remote id?
@@ -223,24 +387,27 @@ func (e Static) ToRemote(ctx context.Context, sourceLinked Linked, source, targe
 		return result, shared.NewErrRemoteNotFound(target)
 	}
 
-	// Delete strange links.
-	defer e.repo.DeleteNotLinked()
-
 	// Source linked with target?
-	targetLinked, err := targetRem.Linkables().LinkedEntity(sourceLinked.EntityID())
+	e.dbMu.Lock()
+	targetLinked, err := targetRem.Linkables().LinkedEntity(ctx, sourceLinked.EntityID())
+	e.dbMu.Unlock()
 	if err != nil {
 		return result, err
 	}
 
-	linkedWithTarget := !shared.IsNil(targetLinked)
-
 	// Linked.
-	if linkedWithTarget {
+	if !shared.IsNil(targetLinked) {
 		result.Linked = targetLinked
 
 		// Missing?
 		result.MissingBefore = targetLinked.RemoteID() == nil
 		result.MissingNow = result.MissingBefore
+
+		// Set by human. Don't touch.
+		if targetLinked.Pinned() {
+			return result, err
+		}
+
 		if !result.MissingBefore {
 			// Not missing.
 			return result, err
@@ -263,113 +430,231 @@ func (e Static) ToRemote(ctx context.Context, sourceLinked Linked, source, targe
 	// Not linked with target OR linked, but missing (need to recheck).
 
 	// Try to get entity from source remote.
-	entityFromSourceRemote, err := sourceRem.RemoteEntity(ctx, *sourceLinked.RemoteID())
+	entityFromSourceRemote, err := sourceEntity(ctx, sourceRem)
 	if err != nil {
 		return result, err
 	}
 
+	// Exists in source? Search entity from source remote in target.
+	var (
+		foundInTarget RemoteEntity
+		match         MatchResult
+	)
+	if !shared.IsNil(entityFromSourceRemote) {
+		foundInTarget, match, err = e.search(ctx, entityFromSourceRemote, target)
+		if err != nil {
+			return result, err
+		}
+		result.Match = match
+	}
+
+	e.dbMu.Lock()
+	defer e.dbMu.Unlock()
+
+	err = e.repo.Transaction(ctx, func(ctx context.Context) error {
+		if err := e.linkTarget(ctx, &result, sourceLinked, targetRem, entityFromSourceRemote, foundInTarget, match); err != nil {
+			return err
+		}
+		// Delete strange links.
+		return e.repo.DeleteNotLinked(ctx)
+	})
+	return result, err
+}
+
+// Link source with found in target (or mark missing). Must be called with dbMu locked, in transaction.
+func (e Static) linkTarget(
+	ctx context.Context,
+	result *ToRemoteResult,
+	sourceLinked Linked,
+	targetRem Remote,
+	entityFromSourceRemote, foundInTarget RemoteEntity,
+	match MatchResult,
+) error {
+	// Linked while searching? (same entity in another call)
+	targetLinked, err := targetRem.Linkables().LinkedEntity(ctx, sourceLinked.EntityID())
+	if err != nil {
+		return err
+	}
+	linkedWithTarget := !shared.IsNil(targetLinked)
+	if linkedWithTarget {
+		result.Linked = targetLinked
+		// Pinned while searching.
+		if targetLinked.Pinned() {
+			result.MissingNow = targetLinked.RemoteID() == nil
+			return err
+		}
+	}
+
 	// Not exists?
 	if shared.IsNil(entityFromSourceRemote) {
 		result.MissingNow = true
 		// Probably entity deleted from remote. Mark both as missing.
-		if err = sourceLinked.SetRemoteID(nil); err != nil {
-			return result, err
+		if !sourceLinked.Pinned() {
+			if err = sourceLinked.SetRemoteID(ctx, nil, MatchResult{}); err != nil {
+				return err
+			}
 		}
 		if linkedWithTarget {
-			if err := targetLinked.SetRemoteID(nil); err != nil {
-				return result, err
-			}
-			return result, err
+			return targetLinked.SetRemoteID(ctx, nil, MatchResult{})
 		}
 		result.NewLink = true
-		linked, err := targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), nil)
-		if err != nil {
-			return result, err
-		}
-		result.Linked = linked
-		return result, err
-	}
-
-	// Exists in source. Search entity from source remote in target.
-	foundInTarget, err := e.search(ctx, entityFromSourceRemote, target)
-	if err != nil {
-		return result, err
+		result.Linked, err = targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), nil, MatchResult{})
+		return err
 	}
 
 	// Not found in target remote?
 	if shared.IsNil(foundInTarget) {
 		if linkedWithTarget {
 			// Stay missing.
-			return result, err
+			return err
 		}
 		// Create link, mark as missing.
 		result.NewLink = true
-		linked, err := targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), nil)
-		result.Linked = linked
-		return result, err
+		result.Linked, err = targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), nil, MatchResult{})
+		return err
 	}
 
 	// Found.
 	foundID := foundInTarget.ID()
 	result.MissingNow = false
 
+	if err := e.reviewIfAmbiguous(ctx, sourceLinked.EntityID(), entityFromSourceRemote, targetRem.Name(), match); err != nil {
+		return err
+	}
+
 	// Link exists?
 	if linkedWithTarget {
-		return result, targetLinked.SetRemoteID(&foundID)
+		return targetLinked.SetRemoteID(ctx, &foundID, match)
 	}
 
 	// Link not exists. Create.
 	result.NewLink = true
-	targetLinked, err = targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), &foundID)
-	if err != nil {
-		return result, err
-	}
+	result.Linked, err = targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), &foundID, match)
+	return err
+}
 
-	result.Linked = targetLinked
-	return result, err
+// Add entity to review queue if there are several similar candidates in target.
+func (e Static) reviewIfAmbiguous(ctx context.Context, entityID shared.EntityID, source RemoteEntity, target shared.RemoteName, match MatchResult) error {
+	if len(match.Candidates) < 2 {
+		return nil
+	}
+	slog.Warn("AMBIGUOUS MATCH (added to review)", "name", source.Name(), "candidates", len(match.Candidates))
+	return e.repo.AddReview(ctx, entityID, source, target, match.Candidates)
 }
 
-// Find an entities to link with target.
-//
-// Returns:
-//
-// 1. Found SOURCE in TARGET, linked TARGET.
+// Entity exists in remote?
 //
-// 2. nil, nil, nil if SOURCE not found in TARGET.
-//
-// 3. ok, nil, nil if SOURCE found in TARGET, but not linked.
-func (e Static) findEntityToLink(ctx context.Context, source RemoteEntity, target shared.RemoteName) (RemoteEntity, Linked, error) {
-	slog.Info("FIND ENTITY FOR", "from", source.RemoteName().String(), "name", source.Name(), "remoteID", source.ID().String())
-
-	// Find target.
-	foundTarget, err := e.search(ctx, source, target)
+// Example: check ID entered by human before linking.
+func (e Static) EntityExists(ctx context.Context, remoteName shared.RemoteName, id shared.RemoteID) (bool, error) {
+	rem, ok := e.remotes[remoteName]
+	if !ok {
+		return false, shared.NewErrRemoteNotFound(remoteName)
+	}
+	entity, err := e.remoteEntity(ctx, rem, id)
 	if err != nil {
-		return nil, nil, err
+		return false, err
 	}
+	return !shared.IsNil(entity), err
+}
 
-	// Missing?
-	if shared.IsNil(foundTarget) {
-		// Missing.
-		return nil, nil, nil
-	}
+// Link entity from source with entity from target by human. Both links will be pinned.
+//
+// Nil targetID - source entity missing in target.
+func (e Static) LinkManual(ctx context.Context, source shared.RemoteName, sourceID shared.RemoteID, target shared.RemoteName, targetID *shared.RemoteID) (Linked, error) {
+	slog.Info("linkManual", "from", source.String(), "remoteID", sourceID.String(), "to", target.String())
 
-	// Linked?
+	sourceRem, ok := e.remotes[source]
+	if !ok {
+		return nil, shared.NewErrRemoteNotFound(source)
+	}
 	targetRem, ok := e.remotes[target]
 	if !ok {
-		return nil, nil, shared.NewErrRemoteNotFound(target)
+		return nil, shared.NewErrRemoteNotFound(target)
 	}
-	linked, err := targetRem.Linkables().LinkedRemoteID(foundTarget.ID())
+
+	// Entities exists?
+	sourceEntity, err := e.remoteEntity(ctx, sourceRem, sourceID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	if shared.IsNil(sourceEntity) {
+		return nil, fmt.Errorf("%s: entity not found (id: %s)", source.String(), sourceID.String())
+	}
+	if targetID != nil {
+		targetEntity, err := e.remoteEntity(ctx, targetRem, *targetID)
+		if err != nil {
+			return nil, err
+		}
+		if shared.IsNil(targetEntity) {
+			return nil, fmt.Errorf("%s: entity not found (id: %s)", target.String(), targetID.String())
+		}
 	}
 
-	// Not linked.
-	if shared.IsNil(linked) {
-		return foundTarget, nil, err
+	targetMatch := matchManual
+	if targetID == nil {
+		targetMatch = MatchResult{Method: MatchMethodManual}
 	}
 
-	// All found.
-	return foundTarget, linked, err
+	e.dbMu.Lock()
+	defer e.dbMu.Unlock()
+
+	var linked Linked
+	err = e.repo.Transaction(ctx, func(ctx context.Context) (err error) {
+		linked, err = e.linkManual(ctx, sourceRem, sourceID, targetRem, targetID, targetMatch)
+		return err
+	})
+	return linked, err
+}
+
+// Must be called with dbMu locked, in transaction.
+func (e Static) linkManual(ctx context.Context, sourceRem Remote, sourceID shared.RemoteID, targetRem Remote, targetID *shared.RemoteID, targetMatch MatchResult) (Linked, error) {
+	// Source linked?
+	sourceLinked, err := sourceRem.Linkables().LinkedRemoteID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if shared.IsNil(sourceLinked) {
+		// Target linked? Then link source with target entity.
+		var entityID shared.EntityID
+		if targetID != nil {
+			targetLinked, err := targetRem.Linkables().LinkedRemoteID(ctx, *targetID)
+			if err != nil {
+				return nil, err
+			}
+			if !shared.IsNil(targetLinked) {
+				entityID = targetLinked.EntityID()
+			}
+		}
+		if len(entityID) == 0 {
+			if entityID, err = e.repo.CreateEntity(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if sourceLinked, err = sourceRem.Linkables().CreateLink(ctx, entityID, &sourceID, matchManual); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sourceLinked.SetPinned(ctx, true); err != nil {
+		return nil, err
+	}
+
+	// Link with target.
+	targetLinked, err := targetRem.Linkables().LinkedEntity(ctx, sourceLinked.EntityID())
+	if err != nil {
+		return nil, err
+	}
+	if shared.IsNil(targetLinked) {
+		targetLinked, err = targetRem.Linkables().CreateLink(ctx, sourceLinked.EntityID(), targetID, targetMatch)
+	} else {
+		err = targetLinked.SetRemoteID(ctx, targetID, targetMatch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return targetLinked, targetLinked.SetPinned(ctx, true)
 }
 
 // Search any remote entity in any remote.
@@ -379,10 +664,10 @@ func (e Static) findEntityToLink(ctx context.Context, source RemoteEntity, targe
 // Returns remote entity from target.
 //
 // Nil if not exists.
-func (e Static) search(ctx context.Context, source RemoteEntity, target shared.RemoteName) (RemoteEntity, error) {
+func (e Static) search(ctx context.Context, source RemoteEntity, target shared.RemoteName) (RemoteEntity, MatchResult, error) {
 	targetRem, ok := e.remotes[target]
 	if !ok {
-		return nil, shared.NewErrRemoteNotFound(target)
+		return nil, MatchResult{}, shared.NewErrRemoteNotFound(target)
 	}
 
 	slog.Info("==== 🔎 ====", "from", source.RemoteName().String(),
@@ -393,20 +678,35 @@ func (e Static) search(ctx context.Context, source RemoteEntity, target shared.R
 	// same remotes.
 	if target == source.RemoteName() {
 		slog.Info("✅ (same remotes)")
-		return source, nil
+		return source, matchSame, nil
 	}
 
 	// Match.
-	matched, err := targetRem.Match(ctx, source)
+	release, err := e.limiters[target].acquire(ctx)
 	if err != nil {
-		return nil, err
+		return nil, MatchResult{}, err
+	}
+	matched, match, err := targetRem.Match(ctx, source)
+	release()
+	if err != nil {
+		return nil, match, err
 	}
 	if shared.IsNil(matched) {
 		slog.Info("❌")
-		return nil, err
+		return nil, MatchResult{}, err
 	}
 
-	slog.Info("✅", "matchedRemoteID", matched.ID().String())
+	slog.Info("✅", "matchedRemoteID", matched.ID().String(), "score", match.Score, "method", match.Method.String())
 
-	return matched, err
+	return matched, match, err
+}
+
+// Get entity by ID from remote.
+func (e Static) remoteEntity(ctx context.Context, rem Remote, id shared.RemoteID) (RemoteEntity, error) {
+	release, err := e.limiters[rem.Name()].acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return rem.RemoteEntity(ctx, id)
 }
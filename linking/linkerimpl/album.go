@@ -12,59 +12,71 @@ import (
 func NewAlbums() (*linker.Static, error) {
 	converted := map[shared.RemoteName]linker.Remote{}
 	for name := range _remotes {
-		converted[name] = AlbumsRemote{repo: _remotes[name].Repository()}
+		converted[name] = AlbumsRemote{repo: _remotes[name].Repository(), limits: _remotes[name].Limits()}
 	}
 
 	return linker.NewStatic(repository.AlbumEntity, converted), nil
 }
 
 type AlbumsRemote struct {
-	repo shared.RemoteRepository
+	repo   shared.RemoteRepository
+	limits shared.RemoteLimits
 }
 
 func (e AlbumsRemote) Name() shared.RemoteName {
 	return e.repo.Name()
 }
 
+func (e AlbumsRemote) Limits() shared.RemoteLimits {
+	return e.limits
+}
+
 func (e AlbumsRemote) RemoteEntity(ctx context.Context, id shared.RemoteID) (linker.RemoteEntity, error) {
 	actions, err := e.repo.Actions()
 	if err != nil {
 		return nil, err
 	}
 	al, err := actions.Album(ctx, id)
+	if err == nil {
+		cacheAlbum(ctx, al)
+	}
 	return al, err
 }
 
+func (e AlbumsRemote) CachedEntity(ctx context.Context, id shared.RemoteID) linker.RemoteEntity {
+	return cachedAlbum(ctx, e.repo.Name(), id)
+}
+
 func (e AlbumsRemote) Linkables() linker.Linkables {
 	return repository.NewLinkableEntity(repository.EntityNameAlbum, e.repo.Name())
 }
 
-func (e AlbumsRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, error) {
+func (e AlbumsRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, linker.MatchResult, error) {
 	realTarget, ok := target.(shared.RemoteAlbum)
 	if !ok {
-		return nil, errors.New("realTarget, ok := target.(shared.RemoteAlbum)")
+		return nil, linker.MatchResult{}, errors.New("realTarget, ok := target.(shared.RemoteAlbum)")
 	}
 
 	// if same remotes
 	if e.repo.Name() == realTarget.RemoteName() {
-		return target, nil
+		return target, linker.MatchResult{Score: 1, Method: linker.MatchMethodExact, Version: MatcherVersion}, nil
 	}
 
 	// Search in target.
 	actions, err := e.repo.Actions()
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 	albums, err := actions.SearchAlbums(ctx, realTarget)
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 
 	// Match.
-	matched := matchAlbum(realTarget, albums[:])
+	matched, match := matchAlbum(realTarget, albums[:])
 	if shared.IsNil(matched) {
-		return nil, nil
+		return nil, match, nil
 	}
 
-	return matched, nil
+	return matched, match, nil
 }
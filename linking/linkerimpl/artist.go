@@ -14,56 +14,68 @@ import (
 func NewArtists() (*linker.Static, error) {
 	converted := map[shared.RemoteName]linker.Remote{}
 	for name := range _remotes {
-		converted[name] = ArtistsRemote{repo: _remotes[name].Repository()}
+		converted[name] = ArtistsRemote{repo: _remotes[name].Repository(), limits: _remotes[name].Limits()}
 	}
 
 	return linker.NewStatic(repository.ArtistEntity, converted), nil
 }
 
 type ArtistsRemote struct {
-	repo shared.RemoteRepository
+	repo   shared.RemoteRepository
+	limits shared.RemoteLimits
 }
 
 func (e ArtistsRemote) Name() shared.RemoteName {
 	return e.repo.Name()
 }
 
+func (e ArtistsRemote) Limits() shared.RemoteLimits {
+	return e.limits
+}
+
 func (e ArtistsRemote) RemoteEntity(ctx context.Context, id shared.RemoteID) (linker.RemoteEntity, error) {
 	actions, err := e.repo.Actions()
 	if err != nil {
 		return nil, err
 	}
 	ar, err := actions.Artist(ctx, id)
+	if err == nil {
+		cacheArtist(ctx, ar)
+	}
 	return ar, err
 }
 
+func (e ArtistsRemote) CachedEntity(ctx context.Context, id shared.RemoteID) linker.RemoteEntity {
+	return cachedArtist(ctx, e.repo.Name(), id)
+}
+
 func (e ArtistsRemote) Linkables() linker.Linkables {
 	return repository.NewLinkableEntity(repository.EntityNameArtist, e.repo.Name())
 }
 
-func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, error) {
+func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, linker.MatchResult, error) {
 	realTarget, ok := target.(shared.RemoteArtist)
 	if !ok {
-		return nil, errors.New("realTarget, ok := target.(shared.RemoteArtist)")
+		return nil, linker.MatchResult{}, errors.New("realTarget, ok := target.(shared.RemoteArtist)")
 	}
 
 	// If same remotes.
 	if e.repo.Name() == realTarget.RemoteName() {
-		return target, nil
+		return target, linker.MatchResult{Score: 1, Method: linker.MatchMethodExact, Version: MatcherVersion}, nil
 	}
 
 	actions, err := e.repo.Actions()
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 
 	oldestAlbumsNames, err := realTarget.OldestAlbumsNames(ctx)
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 	oldestSinglesNames, err := realTarget.OldestSinglesNames(ctx)
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 	normalizedOldestAlbumsNames := shared.NormalizeStringSliceSearchablePart(oldestAlbumsNames[:])
 	normalizedOldestSinglesNames := shared.NormalizeStringSliceSearchablePart(oldestSinglesNames[:])
@@ -71,7 +83,7 @@ func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (l
 	var candidate artistCandidate
 	searchResult, err := actions.SearchArtists(ctx, realTarget)
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 	for i := range searchResult {
 		if shared.IsNil(searchResult[i]) {
@@ -80,13 +92,14 @@ func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (l
 
 		// If target dont have albums and singles.
 		if len(oldestAlbumsNames) == 0 && len(oldestSinglesNames) == 0 {
-			return searchResult[i], err
+			score := shared.CompareNames(target.Name(), searchResult[i].Name())
+			return searchResult[i], linker.MatchResult{Score: score, Method: linker.MatchMethodName, Version: MatcherVersion}, err
 		}
 
 		// Albums.
 		fAlbumsNames, err := searchResult[i].OldestAlbumsNames(ctx)
 		if err != nil {
-			return nil, err
+			return nil, linker.MatchResult{}, err
 		}
 		nAlbumsNames := shared.NormalizeStringSliceSearchablePart(fAlbumsNames[:])
 		albumsWeight := shared.SameNameSlices(normalizedOldestAlbumsNames, nAlbumsNames)
@@ -94,7 +107,7 @@ func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (l
 		// Singles.
 		fSinglesNames, err := searchResult[i].OldestSinglesNames(ctx)
 		if err != nil {
-			return nil, err
+			return nil, linker.MatchResult{}, err
 		}
 		nSinglesNames := shared.NormalizeStringSliceSearchablePart(fSinglesNames[:])
 		singlesWeight := shared.SameNameSlices(normalizedOldestSinglesNames, nSinglesNames)
@@ -112,13 +125,16 @@ func (e ArtistsRemote) Match(ctx context.Context, target linker.RemoteEntity) (l
 			// Just compare first result by name.
 			if strings.EqualFold(shared.Normalize(target.Name()), shared.Normalize(searchResult[0].Name())) {
 				slog.Warn("POTENTIAL MISMATCH (compared by names only)")
-				return searchResult[0], err
+				score := shared.CompareNames(target.Name(), searchResult[0].Name())
+				return searchResult[0], linker.MatchResult{Score: score, Method: linker.MatchMethodName, Version: MatcherVersion}, err
 			}
 		}
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 
-	return candidate.candidate, err
+	// Albums weight + singles weight.
+	score := candidate.weight / 2
+	return candidate.candidate, linker.MatchResult{Score: score, Method: linker.MatchMethodFuzzy, Version: MatcherVersion}, err
 }
 
 type artistCandidate struct {
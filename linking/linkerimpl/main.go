@@ -1,16 +1,29 @@
 package linkerimpl
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/repository"
 	"github.com/oklookat/synchro/shared"
 )
 
+// Increase when matching logic changes.
+const MatcherVersion = 2
+
 var (
 	_remotes map[shared.RemoteName]shared.Remote
 )
 
 func Boot(remotes map[shared.RemoteName]shared.Remote) {
 	_remotes = remotes
+
+	if ttl := metadataTTL(); ttl > 0 {
+		if err := repository.DeleteExpiredMetadata(context.Background(), ttl); err != nil {
+			slog.Warn("Expired metadata not deleted", "error", err.Error())
+		}
+	}
 }
 
 func NewRemoteEntity(from shared.RemoteEntity) linker.RemoteEntity {
@@ -1,17 +1,20 @@
 package linkerimpl
 
 import (
+	"sort"
 	"strings"
 
+	"github.com/oklookat/synchro/linking/linker"
 	"github.com/oklookat/synchro/shared"
 )
 
 // Get the most similar album from the array, based on origin.
 //
 // If there are no similar albums, returns nil.
-func matchAlbum(origin shared.RemoteAlbum, albums []shared.RemoteAlbum) shared.RemoteAlbum {
+func matchAlbum(origin shared.RemoteAlbum, albums []shared.RemoteAlbum) (shared.RemoteAlbum, linker.MatchResult) {
 	lastWeight := 0.0
 	bestIndex := 0
+	var candidates []linker.MatchCandidate
 
 	for i := range albums {
 		if shared.IsNil(albums[i]) {
@@ -20,7 +23,10 @@ func matchAlbum(origin shared.RemoteAlbum, albums []shared.RemoteAlbum) shared.R
 
 		weight, exact := compareAlbums(origin, albums[i])
 		if exact {
-			return albums[i]
+			return albums[i], linker.MatchResult{Score: 1, Method: linker.MatchMethodExact, Version: MatcherVersion}
+		}
+		if weight >= 1 {
+			return albums[i], linker.MatchResult{Score: 1, Method: linker.MatchMethodFuzzy, Version: MatcherVersion}
 		}
 
 		// Skip the unlikely.
@@ -28,6 +34,12 @@ func matchAlbum(origin shared.RemoteAlbum, albums []shared.RemoteAlbum) shared.R
 			continue
 		}
 
+		candidates = append(candidates, linker.MatchCandidate{
+			ID:    albums[i].ID(),
+			Name:  albums[i].Name(),
+			Score: weight,
+		})
+
 		if weight > lastWeight {
 			lastWeight = weight
 			bestIndex = i
@@ -35,10 +47,15 @@ func matchAlbum(origin shared.RemoteAlbum, albums []shared.RemoteAlbum) shared.R
 	}
 
 	if lastWeight == 0 || len(albums) == 0 {
-		return nil
+		return nil, linker.MatchResult{}
 	}
 
-	return albums[bestIndex]
+	return albums[bestIndex], linker.MatchResult{
+		Score:      lastWeight,
+		Method:     linker.MatchMethodFuzzy,
+		Version:    MatcherVersion,
+		Candidates: ambiguousCandidates(candidates),
+	}
 }
 
 // Compare albums.
@@ -70,9 +87,14 @@ func compareAlbums(first, second shared.RemoteAlbum) (totalWeight float64, exact
 		1: 0.09,
 		0: 0.1,
 	}
-	trackCountWeight := shared.NumDiffWeight(uint64(first.TrackCount()), uint64(second.TrackCount()), trackCountWeightMap)
-	if trackCountWeight == 0 {
-		return 0, false
+	// Unknown track count (like in archives) - half weight,
+	// so it can't be better than a known one.
+	trackCountWeight := 0.05
+	if first.TrackCount() > 0 && second.TrackCount() > 0 {
+		trackCountWeight = shared.NumDiffWeight(uint64(first.TrackCount()), uint64(second.TrackCount()), trackCountWeightMap)
+		if trackCountWeight == 0 {
+			return 0, false
+		}
 	}
 
 	// Compare covers.
@@ -116,7 +138,6 @@ func compareAlbums(first, second shared.RemoteAlbum) (totalWeight float64, exact
 
 	if total >= 0.99 {
 		total = 1
-		return total, true
 	}
 	return total, false
 }
@@ -124,9 +145,10 @@ func compareAlbums(first, second shared.RemoteAlbum) (totalWeight float64, exact
 // Get the most similar track from the array, based on origin.
 //
 // If there are no similar tracks, returns nil.
-func matchTrack(origin shared.RemoteTrack, tracks []shared.RemoteTrack) shared.RemoteTrack {
+func matchTrack(origin shared.RemoteTrack, tracks []shared.RemoteTrack) (shared.RemoteTrack, linker.MatchResult) {
 	lastWeight := 0.0
 	bestIndex := 0
+	var candidates []linker.MatchCandidate
 
 	for i := range tracks {
 		if shared.IsNil(tracks[i]) {
@@ -135,7 +157,10 @@ func matchTrack(origin shared.RemoteTrack, tracks []shared.RemoteTrack) shared.R
 
 		weight, exact := compareTracks(origin, tracks[i])
 		if exact {
-			return tracks[i]
+			return tracks[i], linker.MatchResult{Score: 1, Method: linker.MatchMethodExact, Version: MatcherVersion}
+		}
+		if weight >= 1 {
+			return tracks[i], linker.MatchResult{Score: 1, Method: linker.MatchMethodFuzzy, Version: MatcherVersion}
 		}
 
 		// Skip the unlikely.
@@ -143,6 +168,12 @@ func matchTrack(origin shared.RemoteTrack, tracks []shared.RemoteTrack) shared.R
 			continue
 		}
 
+		candidates = append(candidates, linker.MatchCandidate{
+			ID:    tracks[i].ID(),
+			Name:  tracks[i].Name(),
+			Score: weight,
+		})
+
 		if weight > lastWeight {
 			lastWeight = weight
 			bestIndex = i
@@ -150,10 +181,15 @@ func matchTrack(origin shared.RemoteTrack, tracks []shared.RemoteTrack) shared.R
 	}
 
 	if lastWeight == 0 || len(tracks) == 0 {
-		return nil
+		return nil, linker.MatchResult{}
 	}
 
-	return tracks[bestIndex]
+	return tracks[bestIndex], linker.MatchResult{
+		Score:      lastWeight,
+		Method:     linker.MatchMethodFuzzy,
+		Version:    MatcherVersion,
+		Candidates: ambiguousCandidates(candidates),
+	}
 }
 
 // Compare tracks.
@@ -173,14 +209,17 @@ func compareTracks(first, second shared.RemoteTrack) (totalWeight float64, exact
 
 	// Length.
 	// Remotes can have different track length for same track.
-	lengthWeight := 0.0
-	lengthDiff := shared.NumDiff(uint64(first.LengthMs()), uint64(second.LengthMs()))
-
-	// 1.5 seconds tolerance.
-	if lengthDiff <= 1500 {
+	// Unknown length (like in archives) - half weight,
+	// so it can't be better than a known one.
+	lengthWeight := 0.1
+	if first.LengthMs() > 0 && second.LengthMs() > 0 {
+		lengthDiff := shared.NumDiff(uint64(first.LengthMs()), uint64(second.LengthMs()))
+
+		// 1.5 seconds tolerance.
+		if lengthDiff > 1500 {
+			return 0, false
+		}
 		lengthWeight = 0.2
-	} else {
-		return 0, false
 	}
 
 	// Covers.
@@ -226,7 +265,22 @@ func compareTracks(first, second shared.RemoteTrack) (totalWeight float64, exact
 
 	if total >= 0.99 {
 		total = 1
-		return total, true
 	}
 	return total, false
 }
+
+// Candidates sorted by score (best first), max 5.
+//
+// Nil if less than 2 candidates (match not ambiguous).
+func ambiguousCandidates(candidates []linker.MatchCandidate) []linker.MatchCandidate {
+	if len(candidates) < 2 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	if len(candidates) > 5 {
+		candidates = candidates[:5]
+	}
+	return candidates
+}
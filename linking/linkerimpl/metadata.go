@@ -0,0 +1,116 @@
+package linkerimpl
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/config"
+	"github.com/oklookat/synchro/repository"
+	"github.com/oklookat/synchro/shared"
+)
+
+// Entities got by ID cached in DB,
+// so rechecks not request remotes again until TTL.
+
+// Zero if cache disabled.
+func metadataTTL() time.Duration {
+	cfg, err := config.Get[*config.Linker](config.KeyLinker)
+	if err != nil {
+		return 0
+	}
+	return time.Duration((*cfg).MetadataTTLHours) * time.Hour
+}
+
+// Cache not used for offline entities (already without requests),
+// and remotes that not exists in DB.
+func isCacheable(ent shared.RemoteEntity) bool {
+	if shared.IsNil(ent) {
+		return false
+	}
+	switch ent.(type) {
+	case *archive.OfflineTrack, *archive.OfflineAlbum, *archive.OfflineArtist:
+		return false
+	}
+	_, ok := repository.Remotes[ent.RemoteName()]
+	return ok
+}
+
+// Nil if not cached or expired.
+func cachedTrack(ctx context.Context, remote shared.RemoteName, id shared.RemoteID) shared.RemoteTrack {
+	ttl := metadataTTL()
+	if ttl == 0 {
+		return nil
+	}
+	track, err := repository.Metadata[archive.Track](ctx, repository.EntityNameTrack, remote, id, ttl)
+	if err != nil || track == nil {
+		return nil
+	}
+	return archive.NewOfflineTrack(remote, *track)
+}
+
+// Without album: getting it can request remote.
+func cacheTrack(ctx context.Context, track shared.RemoteTrack) {
+	if metadataTTL() == 0 || !isCacheable(track) {
+		return
+	}
+	converted := archive.NewTrackWithoutAlbum(track)
+	setMetadata(ctx, repository.EntityNameTrack, track, converted)
+}
+
+// Nil if not cached or expired.
+func cachedAlbum(ctx context.Context, remote shared.RemoteName, id shared.RemoteID) shared.RemoteAlbum {
+	ttl := metadataTTL()
+	if ttl == 0 {
+		return nil
+	}
+	album, err := repository.Metadata[archive.Album](ctx, repository.EntityNameAlbum, remote, id, ttl)
+	if err != nil || album == nil {
+		return nil
+	}
+	return archive.NewOfflineAlbum(remote, *album)
+}
+
+func cacheAlbum(ctx context.Context, album shared.RemoteAlbum) {
+	if metadataTTL() == 0 || !isCacheable(album) {
+		return
+	}
+	converted := archive.NewAlbum(album)
+	setMetadata(ctx, repository.EntityNameAlbum, album, converted)
+}
+
+// Nil if not cached or expired.
+func cachedArtist(ctx context.Context, remote shared.RemoteName, id shared.RemoteID) shared.RemoteArtist {
+	ttl := metadataTTL()
+	if ttl == 0 {
+		return nil
+	}
+	artist, err := repository.Metadata[archive.Artist](ctx, repository.EntityNameArtist, remote, id, ttl)
+	if err != nil || artist == nil {
+		return nil
+	}
+	return archive.NewOfflineArtist(remote, *artist)
+}
+
+// Only artists with albums names cached, because names used in matching.
+// Artist keeps requested names, so matching not requests them again.
+func cacheArtist(ctx context.Context, artist shared.RemoteArtist) {
+	if metadataTTL() == 0 || !isCacheable(artist) {
+		return
+	}
+	converted, err := archive.NewLikedArtist(ctx, artist)
+	if err != nil {
+		slog.Warn("Metadata not cached", "entity", repository.EntityNameArtist, "ID", artist.ID().String(), "error", err.Error())
+		return
+	}
+	setMetadata(ctx, repository.EntityNameArtist, artist, converted)
+}
+
+// Cache is optional, so errors only logged.
+func setMetadata(ctx context.Context, entityName repository.EntityName, ent shared.RemoteEntity, data any) {
+	err := repository.SetMetadata(ctx, entityName, ent.RemoteName(), ent.ID(), ent.Name(), data)
+	if err != nil {
+		slog.Warn("Metadata not cached", "entity", entityName, "ID", ent.ID().String(), "error", err.Error())
+	}
+}
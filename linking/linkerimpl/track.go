@@ -12,58 +12,80 @@ import (
 func NewTracks() (*linker.Static, error) {
 	converted := map[shared.RemoteName]linker.Remote{}
 	for name := range _remotes {
-		converted[name] = TracksRemote{repo: _remotes[name].Repository()}
+		converted[name] = TracksRemote{repo: _remotes[name].Repository(), limits: _remotes[name].Limits()}
 	}
 
 	return linker.NewStatic(repository.TrackEntity, converted), nil
 }
 
 type TracksRemote struct {
-	repo shared.RemoteRepository
+	repo   shared.RemoteRepository
+	limits shared.RemoteLimits
 }
 
 func (e TracksRemote) Name() shared.RemoteName {
 	return e.repo.Name()
 }
 
+func (e TracksRemote) Limits() shared.RemoteLimits {
+	return e.limits
+}
+
 func (e TracksRemote) RemoteEntity(ctx context.Context, id shared.RemoteID) (linker.RemoteEntity, error) {
 	actions, err := e.repo.Actions()
 	if err != nil {
 		return nil, err
 	}
-	return actions.Track(ctx, id)
+	track, err := actions.Track(ctx, id)
+	if err == nil {
+		cacheTrack(ctx, track)
+	}
+	return track, err
+}
+
+func (e TracksRemote) CachedEntity(ctx context.Context, id shared.RemoteID) linker.RemoteEntity {
+	return cachedTrack(ctx, e.repo.Name(), id)
 }
 
 func (e TracksRemote) Linkables() linker.Linkables {
 	return repository.NewLinkableEntity(repository.EntityNameTrack, e.repo.Name())
 }
 
-func (e TracksRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, error) {
+func (e TracksRemote) Match(ctx context.Context, target linker.RemoteEntity) (linker.RemoteEntity, linker.MatchResult, error) {
 	realTarget, ok := target.(shared.RemoteTrack)
 	if !ok {
-		return nil, errors.New("realTarget, ok := target.(shared.RemoteTrack)")
+		return nil, linker.MatchResult{}, errors.New("realTarget, ok := target.(shared.RemoteTrack)")
 	}
 
 	// if same remotes
 	if e.repo.Name() == realTarget.RemoteName() {
-		return target, nil
+		return target, linker.MatchResult{Score: 1, Method: linker.MatchMethodExact, Version: MatcherVersion}, nil
 	}
 
 	// Search in target.
 	actions, err := e.repo.Actions()
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 	tracks, err := actions.SearchTracks(ctx, realTarget)
 	if err != nil {
-		return nil, err
+		return nil, linker.MatchResult{}, err
 	}
 
 	// Match.
-	matched := matchTrack(realTarget, tracks[:])
+	matched, match := matchTrack(realTarget, tracks[:])
 	if shared.IsNil(matched) {
-		return nil, nil
+		return nil, match, nil
 	}
 
-	return matched, err
+	return matched, match, err
+}
+
+// Remote for matching without linking.
+func NewTracksRemote(name shared.RemoteName) (*TracksRemote, error) {
+	rem, ok := _remotes[name]
+	if !ok {
+		return nil, shared.NewErrRemoteNotFound(name)
+	}
+	return &TracksRemote{repo: rem.Repository(), limits: rem.Limits()}, nil
 }
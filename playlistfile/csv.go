@@ -0,0 +1,134 @@
+package playlistfile
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+)
+
+var ErrNoNameColumn = errors.New("CSV: track name column not found")
+
+// Header names for track fields (case insensitive).
+// First found column is used.
+type CSVColumns struct {
+	Name    []string
+	Artists []string
+
+	// Separator of names in artists column.
+	// Exportify not escapes it, so artist with separator in name
+	// (like "Tyler, The Creator") will be split.
+	//
+	// Empty: ",".
+	ArtistsSeparator string
+
+	Album    []string
+	ISRC     []string
+	LengthMs []string
+
+	// Year or date like "2001-05-01".
+	Year []string
+}
+
+// Exportify and TuneMyMusic.
+var DefaultCSVColumns = CSVColumns{
+	Name:             []string{"Track Name", "Title"},
+	Artists:          []string{"Artist Name(s)", "Artist Name", "Artist"},
+	ArtistsSeparator: ",",
+	Album:            []string{"Album Name", "Album"},
+	ISRC:             []string{"ISRC"},
+	LengthMs:         []string{"Duration (ms)", "Track Duration (ms)"},
+	Year:             []string{"Release Date", "Album Release Date", "Year"},
+}
+
+// Row from CSV.
+type CSVRow struct {
+	// As in file.
+	Record []string
+
+	Track archive.Track
+}
+
+// Read tracks from CSV with header. Rows without track name skipped.
+func ReadCSV(r io.Reader, columns CSVColumns) (header []string, rows []CSVRow, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err = reader.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	var (
+		nameCol     = csvColumn(header, columns.Name)
+		artistsCol  = csvColumn(header, columns.Artists)
+		albumCol    = csvColumn(header, columns.Album)
+		isrcCol     = csvColumn(header, columns.ISRC)
+		lengthMsCol = csvColumn(header, columns.LengthMs)
+		yearCol     = csvColumn(header, columns.Year)
+	)
+	if nameCol < 0 {
+		return header, nil, ErrNoNameColumn
+	}
+	artistsSep := columns.ArtistsSeparator
+	if len(artistsSep) == 0 {
+		artistsSep = ","
+	}
+
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return header, rows, err
+		}
+		row := CSVRow{Record: record}
+		row.Track.Name = csvValue(record, nameCol)
+		if len(row.Track.Name) == 0 {
+			continue
+		}
+		for _, name := range strings.Split(csvValue(record, artistsCol), artistsSep) {
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				row.Track.Artists = append(row.Track.Artists, archive.Artist{Name: name})
+			}
+		}
+		if album := csvValue(record, albumCol); len(album) > 0 {
+			row.Track.Album = &archive.Album{Name: album}
+		}
+		if isrc := csvValue(record, isrcCol); len(isrc) > 0 {
+			row.Track.ISRC = &isrc
+		}
+		row.Track.LengthMs, _ = strconv.Atoi(csvValue(record, lengthMsCol))
+		if year := csvValue(record, yearCol); len(year) >= 4 {
+			row.Track.Year, _ = strconv.Atoi(year[:4])
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows, nil
+}
+
+// Column index or -1.
+func csvColumn(header []string, names []string) int {
+	for _, name := range names {
+		for i := range header {
+			if strings.EqualFold(strings.TrimSpace(header[i]), name) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func csvValue(record []string, col int) string {
+	if col < 0 || col >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[col])
+}
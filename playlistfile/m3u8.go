@@ -0,0 +1,97 @@
+package playlistfile
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+)
+
+// Extended M3U.
+//
+// https://en.wikipedia.org/wiki/M3U#Extended_M3U
+const (
+	m3uHeader   = "#EXTM3U"
+	m3uPlaylist = "#PLAYLIST:"
+	m3uInf      = "#EXTINF:"
+	m3uAlbum    = "#EXTALB:"
+
+	// Not standard.
+	m3uISRC = "#EXTISRC:"
+)
+
+func writeM3U8(w io.Writer, playlist Playlist) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(m3uHeader + "\n")
+	if len(playlist.Name) > 0 {
+		bw.WriteString(m3uPlaylist + playlist.Name + "\n")
+	}
+	for _, track := range playlist.Tracks {
+		if len(track.Name) > 0 || track.LengthMs > 0 {
+			bw.WriteString(m3uInf + strconv.Itoa(lengthSec(track.LengthMs)) + "," + DisplayTitle(track.Track) + "\n")
+		}
+		if album := albumName(track.Track); len(album) > 0 {
+			bw.WriteString(m3uAlbum + album + "\n")
+		}
+		if track.ISRC != nil {
+			bw.WriteString(m3uISRC + *track.ISRC + "\n")
+		}
+		bw.WriteString(location(track) + "\n")
+	}
+	return bw.Flush()
+}
+
+func readM3U8(r io.Reader) (Playlist, error) {
+	result := Playlist{}
+
+	// Directives before location.
+	current := Track{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		if len(line) == 0 {
+			continue
+		}
+		if val, ok := strings.CutPrefix(line, m3uPlaylist); ok {
+			result.Name = strings.TrimSpace(val)
+			continue
+		}
+		if val, ok := strings.CutPrefix(line, m3uInf); ok {
+			// "123 attrs,Title".
+			length, title, _ := strings.Cut(val, ",")
+			length, _, _ = strings.Cut(length, " ")
+			if sec, err := strconv.Atoi(length); err == nil && sec > 0 {
+				current.LengthMs = sec * 1000
+			}
+			parseDisplayTitle(title, &current.Track)
+			continue
+		}
+		if val, ok := strings.CutPrefix(line, m3uAlbum); ok {
+			current.Album = &archive.Album{Name: strings.TrimSpace(val)}
+			continue
+		}
+		if val, ok := strings.CutPrefix(line, m3uISRC); ok {
+			isrc := strings.TrimSpace(val)
+			current.ISRC = &isrc
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		current.Location = line
+		result.Tracks = append(result.Tracks, current)
+		current = Track{}
+	}
+
+	return result, scanner.Err()
+}
+
+// -1 if unknown.
+func lengthSec(lengthMs int) int {
+	if lengthMs <= 0 {
+		return -1
+	}
+	return (lengthMs + 500) / 1000
+}
@@ -0,0 +1,134 @@
+// Playlist files (M3U8, XSPF, PLS) and CSV track lists.
+//
+// Tracks are archive tracks with location (URL or file path).
+package playlistfile
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+)
+
+type Format string
+
+const (
+	FormatM3U8 Format = "m3u8"
+	FormatXSPF Format = "xspf"
+	FormatPLS  Format = "pls"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported playlist format")
+
+// Format by name like "xspf".
+func ParseFormat(val string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(val))) {
+	case FormatM3U8, "m3u":
+		return FormatM3U8, nil
+	case FormatXSPF:
+		return FormatXSPF, nil
+	case FormatPLS:
+		return FormatPLS, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Format by file extension.
+func FormatFromPath(path string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+type Playlist struct {
+	Name   string
+	Tracks []Track
+}
+
+type Track struct {
+	// URL or file path. Optional.
+	Location string
+
+	archive.Track
+}
+
+func Write(w io.Writer, format Format, playlist Playlist) error {
+	switch format {
+	case FormatM3U8:
+		return writeM3U8(w, playlist)
+	case FormatXSPF:
+		return writeXSPF(w, playlist)
+	case FormatPLS:
+		return writePLS(w, playlist)
+	}
+	return ErrUnsupportedFormat
+}
+
+// Only metadata that format supports will be read.
+func Read(r io.Reader, format Format) (Playlist, error) {
+	switch format {
+	case FormatM3U8:
+		return readM3U8(r)
+	case FormatXSPF:
+		return readXSPF(r)
+	case FormatPLS:
+		return readPLS(r)
+	}
+	return Playlist{}, ErrUnsupportedFormat
+}
+
+// "Artist1, Artist2 - Title".
+func DisplayTitle(track archive.Track) string {
+	if len(track.Artists) == 0 {
+		return track.Name
+	}
+	return artistsNames(track.Artists) + " - " + track.Name
+}
+
+// Reverse of DisplayTitle.
+func parseDisplayTitle(val string, track *archive.Track) {
+	val = strings.TrimSpace(val)
+	artists, title, ok := strings.Cut(val, " - ")
+	if !ok {
+		track.Name = val
+		return
+	}
+	track.Name = strings.TrimSpace(title)
+	track.Artists = parseArtistsNames(artists)
+}
+
+// "Artist1, Artist2".
+func artistsNames(artists []archive.Artist) string {
+	names := make([]string, len(artists))
+	for i := range artists {
+		names[i] = artists[i].Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// Reverse of artistsNames.
+func parseArtistsNames(val string) []archive.Artist {
+	result := []archive.Artist{}
+	for _, name := range strings.Split(val, ", ") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			result = append(result, archive.Artist{Name: name})
+		}
+	}
+	return result
+}
+
+// Album name or empty.
+func albumName(track archive.Track) string {
+	if track.Album == nil {
+		return ""
+	}
+	return track.Album.Name
+}
+
+// Location, or track ID if empty.
+func location(track Track) string {
+	if len(track.Location) > 0 {
+		return track.Location
+	}
+	return track.ID.String()
+}
@@ -0,0 +1,97 @@
+package playlistfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/oklookat/synchro/archive"
+)
+
+func TestWriteRead(t *testing.T) {
+	isrc := "USUM71703861"
+	playlist := Playlist{
+		Name: "Playlist",
+		Tracks: []Track{
+			{
+				Location: "https://example.com/track/1",
+				Track: archive.Track{
+					Name:     "Track",
+					ISRC:     &isrc,
+					Artists:  []archive.Artist{{Name: "Artist1"}, {Name: "Artist2"}},
+					Album:    &archive.Album{Name: "Album"},
+					LengthMs: 123000,
+				},
+			},
+			{
+				Location: "music/2.flac",
+				Track:    archive.Track{Name: "No artists"},
+			},
+		},
+	}
+
+	for _, format := range []Format{FormatM3U8, FormatXSPF, FormatPLS} {
+		buf := &bytes.Buffer{}
+		if err := Write(buf, format, playlist); err != nil {
+			t.Fatal(format, err)
+		}
+		read, err := Read(buf, format)
+		if err != nil {
+			t.Fatal(format, err)
+		}
+
+		if format != FormatPLS && read.Name != playlist.Name {
+			t.Fatalf("%s: bad name: %q", format, read.Name)
+		}
+		if len(read.Tracks) != len(playlist.Tracks) {
+			t.Fatalf("%s: bad tracks count: %d", format, len(read.Tracks))
+		}
+		track := read.Tracks[0]
+		if track.Location != playlist.Tracks[0].Location ||
+			track.Name != "Track" ||
+			track.LengthMs != 123000 ||
+			len(track.Artists) != 2 || track.Artists[1].Name != "Artist2" {
+			t.Fatalf("%s: bad track: %+v", format, track)
+		}
+		if format != FormatPLS && (track.ISRC == nil || *track.ISRC != isrc || track.Album == nil || track.Album.Name != "Album") {
+			t.Fatalf("%s: bad ISRC or album: %+v", format, track)
+		}
+		if read.Tracks[1].Name != "No artists" || read.Tracks[1].LengthMs != 0 {
+			t.Fatalf("%s: bad track: %+v", format, read.Tracks[1])
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	data := "\ufeffTrack Name,Artist Name(s),Album Name,Release Date,Duration (ms),ISRC\n" +
+		"Track,\"Artist1,Artist2\",Album,2001-05-01,123000,USUM71703861\n" +
+		",Skipped,,,,\n"
+	header, rows, err := ReadCSV(bytes.NewBufferString(data), DefaultCSVColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(header) != 6 || header[0] != "Track Name" {
+		t.Fatalf("bad header: %v", header)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("bad rows count: %d", len(rows))
+	}
+	track := rows[0].Track
+	if track.Name != "Track" || len(track.Artists) != 2 || track.Album == nil ||
+		track.Year != 2001 || track.LengthMs != 123000 || track.ISRC == nil {
+		t.Fatalf("bad track: %+v", track)
+	}
+
+	columns := DefaultCSVColumns
+	columns.ArtistsSeparator = ";"
+	data = "Track Name,Artist Name(s)\nTrack,\"Tyler, The Creator;Artist2\"\n"
+	if _, rows, err = ReadCSV(bytes.NewBufferString(data), columns); err != nil {
+		t.Fatal(err)
+	}
+	if artists := rows[0].Track.Artists; len(artists) != 2 || artists[0].Name != "Tyler, The Creator" {
+		t.Fatalf("bad artists: %+v", artists)
+	}
+
+	if _, _, err := ReadCSV(bytes.NewBufferString("A,B\n"), DefaultCSVColumns); err != ErrNoNameColumn {
+		t.Fatalf("expected ErrNoNameColumn, got %v", err)
+	}
+}
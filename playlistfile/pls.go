@@ -0,0 +1,81 @@
+package playlistfile
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Only location, title and length.
+//
+// https://en.wikipedia.org/wiki/PLS_(file_format)
+func writePLS(w io.Writer, playlist Playlist) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("[playlist]\n")
+	for i, track := range playlist.Tracks {
+		num := strconv.Itoa(i + 1)
+		bw.WriteString("File" + num + "=" + location(track) + "\n")
+		bw.WriteString("Title" + num + "=" + DisplayTitle(track.Track) + "\n")
+		bw.WriteString("Length" + num + "=" + strconv.Itoa(lengthSec(track.LengthMs)) + "\n")
+	}
+	bw.WriteString("NumberOfEntries=" + strconv.Itoa(len(playlist.Tracks)) + "\n")
+	bw.WriteString("Version=2\n")
+	return bw.Flush()
+}
+
+func readPLS(r io.Reader) (Playlist, error) {
+	// Tracks by entry number.
+	tracks := map[int]*Track{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, val, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok {
+			continue
+		}
+		var field string
+		for _, prefix := range []string{"File", "Title", "Length"} {
+			if strings.HasPrefix(key, prefix) {
+				field = prefix
+				break
+			}
+		}
+		num, err := strconv.Atoi(strings.TrimPrefix(key, field))
+		if len(field) == 0 || err != nil {
+			continue
+		}
+		track, ok := tracks[num]
+		if !ok {
+			track = &Track{}
+			tracks[num] = track
+		}
+		val = strings.TrimSpace(val)
+		switch field {
+		case "File":
+			track.Location = val
+		case "Title":
+			parseDisplayTitle(val, &track.Track)
+		case "Length":
+			if sec, err := strconv.Atoi(val); err == nil && sec > 0 {
+				track.LengthMs = sec * 1000
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Playlist{}, err
+	}
+
+	nums := make([]int, 0, len(tracks))
+	for num := range tracks {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	result := Playlist{}
+	for _, num := range nums {
+		if len(tracks[num].Location) > 0 {
+			result.Tracks = append(result.Tracks, *tracks[num])
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package playlistfile
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/oklookat/synchro/archive"
+)
+
+// https://www.xspf.org/spec
+const (
+	xspfNamespace = "http://xspf.org/ns/0/"
+	xspfISRC      = "urn:isrc:"
+)
+
+type xspfPlaylist struct {
+	XMLName xml.Name    `xml:"playlist"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Version string      `xml:"version,attr"`
+	Title   string      `xml:"title,omitempty"`
+	Tracks  []xspfTrack `xml:"trackList>track"`
+}
+
+type xspfTrack struct {
+	Location   []string `xml:"location,omitempty"`
+	Identifier []string `xml:"identifier,omitempty"`
+	Title      string   `xml:"title,omitempty"`
+	Creator    string   `xml:"creator,omitempty"`
+	Album      string   `xml:"album,omitempty"`
+	Duration   int      `xml:"duration,omitempty"`
+	Image      string   `xml:"image,omitempty"`
+}
+
+func writeXSPF(w io.Writer, playlist Playlist) error {
+	converted := xspfPlaylist{
+		Xmlns:   xspfNamespace,
+		Version: "1",
+		Title:   playlist.Name,
+		Tracks:  make([]xspfTrack, len(playlist.Tracks)),
+	}
+	for i, track := range playlist.Tracks {
+		conv := xspfTrack{
+			Location: []string{location(track)},
+			Title:    track.Name,
+			Creator:  artistsNames(track.Artists),
+			Album:    albumName(track.Track),
+			Duration: track.LengthMs,
+			Image:    track.CoverURL,
+		}
+		if track.ISRC != nil {
+			conv.Identifier = []string{xspfISRC + *track.ISRC}
+		}
+		converted.Tracks[i] = conv
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(converted); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readXSPF(r io.Reader) (Playlist, error) {
+	converted := xspfPlaylist{}
+	if err := xml.NewDecoder(r).Decode(&converted); err != nil {
+		return Playlist{}, err
+	}
+
+	result := Playlist{
+		Name:   strings.TrimSpace(converted.Title),
+		Tracks: make([]Track, len(converted.Tracks)),
+	}
+	for i, track := range converted.Tracks {
+		conv := Track{
+			Track: archive.Track{
+				Name:     strings.TrimSpace(track.Title),
+				Artists:  parseArtistsNames(track.Creator),
+				LengthMs: track.Duration,
+				CoverURL: strings.TrimSpace(track.Image),
+			},
+		}
+		if len(track.Location) > 0 {
+			conv.Location = strings.TrimSpace(track.Location[0])
+		}
+		if album := strings.TrimSpace(track.Album); len(album) > 0 {
+			conv.Album = &archive.Album{Name: album}
+		}
+		for _, id := range track.Identifier {
+			if isrc, ok := strings.CutPrefix(strings.TrimSpace(id), xspfISRC); ok {
+				conv.ISRC = &isrc
+				break
+			}
+		}
+		result.Tracks[i] = conv
+	}
+
+	return result, nil
+}
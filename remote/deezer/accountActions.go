@@ -2,6 +2,7 @@ package deezer
 
 import (
 	"context"
+	"time"
 
 	"github.com/oklookat/deezus"
 	"github.com/oklookat/deezus/schema"
@@ -41,22 +42,24 @@ func (e AccountActions) Playlist() shared.PlaylistActions {
 }
 
 type LikedAlbumsActions struct {
-	client *deezus.Client
+	client  *deezus.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	albums := []shared.RemoteEntity{}
+	e.likedAt = shared.LikedDates{}
 
 	offset := 0
 	const limit = 60
 
 	for {
-		albumsd, err := e.client.UserMeAlbums(ctx, offset, limit)
+		albumsd, err := userLibrary[schema.SimpleAlbum](ctx, e.client, "albums", offset, limit)
 		if err != nil {
 			if isNotFound(err) {
 				break
 			}
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		if len(albumsd.Data) == 0 {
@@ -64,11 +67,14 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 		}
 
 		for _, al := range albumsd.Data {
-			conv, err := newAlbum(ctx, e.client, al.ID)
+			conv, err := newAlbum(ctx, e.client, al.item.ID)
 			if err != nil {
-				return nil, err
+				return nil, wrapErr(err)
 			}
 			albums = append(albums, conv)
+			if al.likedAt != nil {
+				e.likedAt[shared.RemoteID(al.item.ID.String())] = al.likedAt.Time()
+			}
 		}
 
 		offset += limit
@@ -77,6 +83,10 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return albums, nil
 }
 
+func (e LikedAlbumsActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
+}
+
 func (e LikedAlbumsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -90,26 +100,31 @@ func (e LikedAlbumsActions) likeUnlike(ctx context.Context, ids []shared.RemoteI
 }
 
 type LikedArtistsActions struct {
-	client *deezus.Client
+	client  *deezus.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	result := []shared.RemoteEntity{}
+	e.likedAt = shared.LikedDates{}
 
 	const limit = 60
 	offset := 0
 
 	for {
-		resp, err := e.client.UserMeArtists(ctx, offset, limit)
+		resp, err := userLibrary[schema.SimpleArtist](ctx, e.client, "artists", offset, limit)
 		if err != nil {
 			if isNotFound(err) {
 				err = nil
 			}
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
-		for i := range resp.Data {
-			result = append(result, newArtist(e.client, resp.Data[i]))
+		for _, ar := range resp.Data {
+			result = append(result, newArtist(e.client, ar.item))
+			if ar.likedAt != nil {
+				e.likedAt[shared.RemoteID(ar.item.ID.String())] = ar.likedAt.Time()
+			}
 		}
 
 		if len(resp.Data) == 0 || resp.Next == nil || len(*resp.Next) == 0 {
@@ -122,6 +137,10 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 	return result, nil
 }
 
+func (e LikedArtistsActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
+}
+
 func (e LikedArtistsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -135,30 +154,35 @@ func (e LikedArtistsActions) likeUnlike(ctx context.Context, ids []shared.Remote
 }
 
 type LikedTracksActions struct {
-	client *deezus.Client
+	client  *deezus.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	result := []shared.RemoteEntity{}
+	e.likedAt = shared.LikedDates{}
 
 	const limit = 60
 	offset := 0
 
 	for {
-		resp, err := e.client.UserMeTracks(ctx, offset, limit)
+		resp, err := userLibrary[schema.SimpleTrack](ctx, e.client, "tracks", offset, limit)
 		if err != nil {
 			if isNotFound(err) {
 				err = nil
 			}
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
-		for i := range resp.Data {
-			conv, err := newTrack(ctx, e.client, resp.Data[i].ID)
+		for _, tr := range resp.Data {
+			conv, err := newTrack(ctx, e.client, tr.item.ID)
 			if err != nil {
-				return nil, err
+				return nil, wrapErr(err)
 			}
 			result = append(result, conv)
+			if tr.likedAt != nil {
+				e.likedAt[shared.RemoteID(tr.item.ID.String())] = tr.likedAt.Time()
+			}
 		}
 
 		if len(resp.Data) == 0 || resp.Next == nil || len(*resp.Next) == 0 {
@@ -171,6 +195,10 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return result, nil
 }
 
+func (e LikedTracksActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
+}
+
 func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -200,7 +228,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 			if isNotFound(err) {
 				err = nil
 			}
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		for i := range resp.Data {
@@ -212,7 +240,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 			}
 			conv, err := newPlaylist(ctx, e.client, e.account, resp.Data[i].ID)
 			if err != nil {
-				return nil, err
+				return nil, wrapErr(err)
 			}
 			result = append(result, conv)
 		}
@@ -230,10 +258,10 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
 	ideed, err := e.client.CreatePlaylist(ctx, name)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if _, err = e.client.UpdatePlaylist(ctx, ideed.ID, nil, description, &isVisible); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newPlaylist(ctx, e.client, e.account, ideed.ID)
 }
@@ -242,10 +270,10 @@ func (e PlaylistActions) Delete(ctx context.Context, entities []shared.RemoteID)
 	for _, id := range entities {
 		conv, err := remoteToSchemaID(id)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		if _, err := e.client.DeletePlaylist(ctx, conv); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -254,7 +282,7 @@ func (e PlaylistActions) Delete(ctx context.Context, entities []shared.RemoteID)
 func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shared.RemotePlaylist, error) {
 	conv, err := remoteToSchemaID(id)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newPlaylist(ctx, e.client, e.account, conv)
 }
@@ -279,7 +307,7 @@ func addRemove(
 	for i := range converted {
 		conv, err := remoteToSchemaID(ids[i])
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		converted[i] = conv
 	}
@@ -287,7 +315,7 @@ func addRemove(
 	remStatic := func(rem func(ctx context.Context, id schema.ID) (*schema.BoolResponse, error)) error {
 		for _, id := range converted {
 			if _, err := rem(ctx, id); err != nil {
-				return err
+				return wrapErr(err)
 			}
 		}
 		return nil
@@ -296,7 +324,7 @@ func addRemove(
 		idsChunked := shared.ChunkSlice(converted, 25)
 		for _, chunk := range idsChunked {
 			if _, err := add(ctx, chunk); err != nil {
-				return err
+				return wrapErr(err)
 			}
 		}
 		return nil
@@ -23,7 +23,7 @@ type Actions struct {
 func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAlbum, error) {
 	conv, err := remoteToSchemaID(id)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newAlbum(ctx, e.client, conv)
 }
@@ -31,14 +31,14 @@ func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAl
 func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteArtist, error) {
 	conv, err := remoteToSchemaID(id)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	resp, err := e.client.Artist(ctx, conv)
 	if err != nil {
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newArtist(e.client, resp.SimpleArtist), err
 }
@@ -46,7 +46,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTrack, error) {
 	conv, err := remoteToSchemaID(id)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newTrack(ctx, e.client, conv)
 }
@@ -80,7 +80,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 		if isNotFound(err) {
 			err = nil
 		}
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -89,12 +89,12 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 		}
 		conv, err := newAlbum(ctx, e.client, resp.Data[i].ID)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = conv
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type ArtistsSearchAction struct {
@@ -111,7 +111,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		if isNotFound(err) {
 			err = nil
 		}
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -121,7 +121,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		result[i] = newArtist(e.client, resp.Data[i])
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type TracksSearchAction struct {
@@ -138,7 +138,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		if isNotFound(err) {
 			err = nil
 		}
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -147,10 +147,10 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		}
 		conv, err := newTrack(ctx, e.client, resp.Data[i].ID)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = conv
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
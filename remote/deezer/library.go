@@ -0,0 +1,56 @@
+package deezer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/oklookat/deezus"
+	"github.com/oklookat/deezus/schema"
+)
+
+// Item of user library list. Example: track from user/me/tracks.
+//
+// Like date (time_add) returned only in these lists,
+// but client's Simple* types drop it, so lists decoded here.
+type libraryItem[T any] struct {
+	item    T
+	likedAt *schema.Time
+}
+
+func (e *libraryItem[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &e.item); err != nil {
+		return err
+	}
+	added := struct {
+		TimeAdd *schema.Time `json:"time_add"`
+	}{}
+	if err := json.Unmarshal(data, &added); err != nil {
+		return err
+	}
+	e.likedAt = added.TimeAdd
+	return nil
+}
+
+// Example: kind "tracks" - user/me/tracks.
+//
+// Errors same as client returns.
+func userLibrary[T any](ctx context.Context, cl *deezus.Client, kind string, index, limit int) (*schema.Response[[]libraryItem[T]], error) {
+	data := &schema.Response[[]libraryItem[T]]{}
+	req := cl.Http.R().SetResult(data).SetError(data)
+	req.QueryParams().Set("index", strconv.Itoa(index))
+	req.QueryParams().Set("limit", strconv.Itoa(limit))
+
+	resp, err := req.Get(ctx, schema.ApiUrl+"/user/me/"+kind)
+	if err != nil {
+		return nil, err
+	}
+	if data.Error != nil {
+		return nil, fmt.Errorf("deezus: %w", *data.Error)
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("deezus: %d", resp.StatusCode)
+	}
+	return data, err
+}
@@ -0,0 +1,32 @@
+package deezer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oklookat/deezus/schema"
+)
+
+func TestLibraryItem(t *testing.T) {
+	const data = `{"data": [
+		{"id": 3135556, "title": "Harder, Better, Faster, Stronger", "time_add": 1700000000},
+		{"id": 3135553, "title": "One More Time"}
+	]}`
+	resp := &schema.Response[[]libraryItem[schema.SimpleTrack]]{}
+	if err := json.Unmarshal([]byte(data), resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(resp.Data))
+	}
+	first := resp.Data[0]
+	if first.item.ID != 3135556 || first.item.Title != "Harder, Better, Faster, Stronger" {
+		t.Fatalf("bad item: %+v", first.item)
+	}
+	if first.likedAt == nil || first.likedAt.Time().Unix() != 1700000000 {
+		t.Fatalf("bad like date: %v", first.likedAt)
+	}
+	if resp.Data[1].likedAt != nil {
+		t.Fatalf("expected nil like date, got %v", resp.Data[1].likedAt.Time())
+	}
+}
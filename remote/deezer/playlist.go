@@ -20,7 +20,7 @@ func newPlaylist(
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	return &Playlist{
@@ -51,7 +51,7 @@ func (e Playlist) Description() *string {
 
 func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	if err := e.cacheTracks(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return e.cachedTracks, nil
 }
@@ -61,7 +61,7 @@ func (e *Playlist) Rename(ctx context.Context, newName string) error {
 	if err == nil {
 		e.playlist.Title = newName
 	}
-	return err
+	return wrapErr(err)
 }
 
 func (e Playlist) SetDescription(ctx context.Context, newDesc string) error {
@@ -69,7 +69,7 @@ func (e Playlist) SetDescription(ctx context.Context, newDesc string) error {
 	if err == nil {
 		e.playlist.Description = newDesc
 	}
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) IsVisible() (bool, error) {
@@ -81,7 +81,7 @@ func (e *Playlist) SetIsVisible(ctx context.Context, val bool) error {
 	if err == nil {
 		e.playlist.Public = val
 	}
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
@@ -92,6 +92,22 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	return e.addRemoveTracks(ctx, ids, false)
 }
 
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	e.cachedTracks = nil
+
+	converted := make([]schema.ID, 0, len(ids))
+	for _, id := range ids {
+		conv, err := remoteToSchemaID(id)
+		if err != nil {
+			return wrapErr(err)
+		}
+		converted = append(converted, conv)
+	}
+
+	_, err := e.client.OrderTracksInPlaylist(ctx, e.playlist.ID, converted)
+	return wrapErr(err)
+}
+
 func (e *Playlist) addRemoveTracks(ctx context.Context, ids []shared.RemoteID, add bool) error {
 	e.cachedTracks = nil
 
@@ -99,7 +115,7 @@ func (e *Playlist) addRemoveTracks(ctx context.Context, ids []shared.RemoteID, a
 	for _, id := range ids {
 		conv, err := remoteToSchemaID(id)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		converted = append(converted, conv)
 	}
@@ -109,13 +125,13 @@ func (e *Playlist) addRemoveTracks(ctx context.Context, ids []shared.RemoteID, a
 		if add {
 			_, err := e.client.AddTracksToPlaylist(ctx, e.playlist.ID, chunk)
 			if err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		_, err := e.client.RemoveTracksFromPlaylist(ctx, e.playlist.ID, chunk)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -135,13 +151,13 @@ func (e *Playlist) cacheTracks(ctx context.Context) error {
 	for {
 		resp, err := e.client.PlaylistTracks(ctx, e.playlist.ID, offset, limit)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 
 		for _, item := range resp.Data {
 			conv, err := newTrack(ctx, e.client, item.ID)
 			if err != nil {
-				return err
+				return wrapErr(err)
 			}
 			e.cachedTracks = append(e.cachedTracks, conv)
 		}
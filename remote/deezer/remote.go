@@ -63,3 +63,11 @@ func (s Remote) Actions() (shared.RemoteActions, error) {
 func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
 	return shared.GetEntityURL("http://deezer.com", etype, id)
 }
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// Deezer: 50 requests per 5 seconds.
+	return shared.RemoteLimits{
+		Parallelism: 4,
+		Rate:        8,
+	}
+}
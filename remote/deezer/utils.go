@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/oklookat/deezus/schema"
 	"github.com/oklookat/synchro/shared"
@@ -46,3 +47,37 @@ func remoteToSchemaID(id shared.RemoteID) (schema.ID, error) {
 	conv, err := strconv.ParseInt(id.String(), 10, 64)
 	return schema.ID(conv), err
 }
+
+// Convert error to shared.ErrRemote.
+func wrapErr(err error) error {
+	if err == nil || shared.ErrorKindOf(err) != shared.ErrorKindUnknown {
+		return err
+	}
+	return shared.NewErrRemote(_repo.Name(), errorKind(err), err)
+}
+
+func errorKind(err error) shared.ErrorKind {
+	deezErr := &schema.Error{}
+	if errors.As(err, deezErr) {
+		switch deezErr.Code {
+		case schema.ErrorCodeQuota:
+			return shared.ErrorKindRateLimit
+		case schema.ErrorCodePermission, schema.ErrorCodeTokenInvalid:
+			return shared.ErrorKindAuth
+		case schema.ErrorCodeDataNotFound:
+			return shared.ErrorKindNotFound
+		case schema.ErrorCodeServiceBusy:
+			return shared.ErrorKindRemote5xx
+		}
+		return shared.ErrorKindUnknown
+	}
+
+	// Client returns HTTP errors without type.
+	// Example: "deezus: 503".
+	if code, ok := strings.CutPrefix(err.Error(), "deezus: "); ok {
+		if status, err := strconv.Atoi(code); err == nil {
+			return shared.ErrorKindFromStatus(status)
+		}
+	}
+	return shared.ErrorKindUnknown
+}
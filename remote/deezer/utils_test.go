@@ -0,0 +1,35 @@
+package deezer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/oklookat/deezus/schema"
+	"github.com/oklookat/synchro/shared"
+)
+
+func TestErrorKind(t *testing.T) {
+	testCases := []struct {
+		err      error
+		expected shared.ErrorKind
+	}{
+		// Like deezus returns.
+		{fmt.Errorf("deezus: %w", schema.Error{Code: schema.ErrorCodeQuota}), shared.ErrorKindRateLimit},
+		{fmt.Errorf("deezus: %w", schema.Error{Code: schema.ErrorCodeTokenInvalid}), shared.ErrorKindAuth},
+		{fmt.Errorf("deezus: %w", schema.Error{Code: schema.ErrorCodeDataNotFound}), shared.ErrorKindNotFound},
+		{fmt.Errorf("deezus: %w", schema.Error{Code: schema.ErrorCodeServiceBusy}), shared.ErrorKindRemote5xx},
+		{fmt.Errorf("deezus: %w", schema.Error{Code: schema.ErrorCodeParameter}), shared.ErrorKindUnknown},
+		{fmt.Errorf("deezus: %d", 503), shared.ErrorKindRemote5xx},
+		{fmt.Errorf("deezus: %d", 429), shared.ErrorKindRateLimit},
+		{fmt.Errorf("deezus: %d", 401), shared.ErrorKindAuth},
+		{fmt.Errorf("deezus: %d", 400), shared.ErrorKindUnknown},
+		{errors.New("deezus: broken"), shared.ErrorKindUnknown},
+		{errors.New("connection reset"), shared.ErrorKindUnknown},
+	}
+	for _, tc := range testCases {
+		if kind := errorKind(tc.err); kind != tc.expected {
+			t.Errorf("%q: expected %s, got %s", tc.err.Error(), tc.expected, kind)
+		}
+	}
+}
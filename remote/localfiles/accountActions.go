@@ -0,0 +1,223 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Liked tracks playlist, in account directory.
+const _likedFile = "Liked.m3u"
+
+func newAccountActions(account shared.Account) (*AccountActions, error) {
+	lib, err := getLibrary(account.Auth())
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActions{
+		account: account,
+		lib:     lib,
+	}, err
+}
+
+type AccountActions struct {
+	account shared.Account
+	lib     *library
+}
+
+// Albums can't be liked, only owned.
+func (e AccountActions) LikedAlbums() shared.LikedActions {
+	return &notImplementedLikedActions{}
+}
+
+// Artists can't be liked, only owned.
+func (e AccountActions) LikedArtists() shared.LikedActions {
+	return &notImplementedLikedActions{}
+}
+
+func (e AccountActions) LikedTracks() shared.LikedActions {
+	return &LikedTracksActions{lib: e.lib}
+}
+
+func (e AccountActions) Playlist() shared.PlaylistActions {
+	return &PlaylistActions{
+		account: e.account,
+		lib:     e.lib,
+	}
+}
+
+type notImplementedLikedActions struct {
+}
+
+func (e notImplementedLikedActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+	return nil, shared.ErrNotImplemented
+}
+
+func (e notImplementedLikedActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
+func (e notImplementedLikedActions) Like(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e notImplementedLikedActions) Unlike(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+// Tracks from Liked.m3u.
+type LikedTracksActions struct {
+	lib *library
+}
+
+func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+	file, err := e.read()
+	if err != nil {
+		return nil, err
+	}
+	tracks := e.lib.tracksOf(file)
+	result := make([]shared.RemoteEntity, len(tracks))
+	for i := range tracks {
+		result[i] = tracks[i]
+	}
+	return result, err
+}
+
+func (e LikedTracksActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
+func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
+	file, err := e.read()
+	if err != nil {
+		return err
+	}
+	present := map[shared.RemoteID]bool{}
+	for _, path := range file.paths {
+		present[pathToID(path)] = true
+	}
+	for _, id := range ids {
+		if len(id) == 0 || present[id] {
+			continue
+		}
+		present[id] = true
+		file.paths = append(file.paths, idToPath(id))
+	}
+	return writeM3U(e.path(), file, e.lib)
+}
+
+func (e LikedTracksActions) Unlike(ctx context.Context, ids []shared.RemoteID) error {
+	file, err := e.read()
+	if err != nil {
+		return err
+	}
+	file.paths = removePaths(file.paths, ids)
+	return writeM3U(e.path(), file, e.lib)
+}
+
+// Empty if not exists.
+func (e LikedTracksActions) read() (*m3uFile, error) {
+	file, err := readM3U(e.path())
+	if errors.Is(err, fs.ErrNotExist) {
+		return &m3uFile{name: "Liked"}, nil
+	}
+	return file, err
+}
+
+func (e LikedTracksActions) path() string {
+	return filepath.Join(e.lib.dir, _likedFile)
+}
+
+// M3U8 files in account directory.
+type PlaylistActions struct {
+	account shared.Account
+	lib     *library
+}
+
+func (e PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlaylist, error) {
+	paths, err := filepath.Glob(filepath.Join(e.lib.dir, "*"+_playlistExt))
+	if err != nil {
+		return nil, err
+	}
+	result := []shared.RemotePlaylist{}
+	for _, path := range paths {
+		file, err := readM3U(path)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, newPlaylist(e.account, e.lib, path, file))
+	}
+	return result, err
+}
+
+func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
+	// Don't overwrite existing.
+	base := filepath.Join(e.lib.dir, safeFileName(name))
+	path := base + _playlistExt
+	for i := 2; ; i++ {
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		path = base + " (" + strconv.Itoa(i) + ")" + _playlistExt
+	}
+
+	file := &m3uFile{name: name}
+	if err := writeM3U(path, file, e.lib); err != nil {
+		return nil, err
+	}
+	return newPlaylist(e.account, e.lib, path, file), nil
+}
+
+func (e PlaylistActions) Delete(ctx context.Context, ids []shared.RemoteID) error {
+	for _, id := range ids {
+		if len(id) == 0 {
+			continue
+		}
+		path, err := e.playlistPath(id)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shared.RemotePlaylist, error) {
+	path, err := e.playlistPath(id)
+	if err != nil {
+		return nil, err
+	}
+	file, err := readM3U(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// Not found.
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newPlaylist(e.account, e.lib, path, file), err
+}
+
+// Playlist path by ID. Only playlists in library directory allowed.
+func (e PlaylistActions) playlistPath(id shared.RemoteID) (string, error) {
+	path, err := filepath.Abs(idToPath(id))
+	if err != nil {
+		return "", err
+	}
+	dir, err := filepath.Abs(e.lib.dir)
+	if err != nil {
+		return "", err
+	}
+	if filepath.Dir(path) != dir || filepath.Ext(path) != _playlistExt {
+		return "", errors.New("not a playlist: " + id.String())
+	}
+	return path, err
+}
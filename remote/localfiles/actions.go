@@ -0,0 +1,131 @@
+package localfiles
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+func newActions(libs []*library) *Actions {
+	return &Actions{
+		libs: libs,
+	}
+}
+
+// Actions over libraries of all accounts.
+type Actions struct {
+	libs []*library
+}
+
+func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAlbum, error) {
+	for _, lib := range e.libs {
+		if album, ok := lib.albumsByID[id]; ok {
+			return album, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteArtist, error) {
+	for _, lib := range e.libs {
+		if artist, ok := lib.artistsByID[id]; ok {
+			return artist, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTrack, error) {
+	for _, lib := range e.libs {
+		if track, ok := lib.tracksByID[id]; ok {
+			return track, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) SearchAlbums(ctx context.Context, what shared.RemoteAlbum) ([10]shared.RemoteAlbum, error) {
+	found := []scored[shared.RemoteAlbum]{}
+	for _, lib := range e.libs {
+		for _, album := range lib.albums {
+			score := 0.0
+			if sameCode(what.UPC(), album.UPC()) || sameCode(what.EAN(), album.EAN()) {
+				score = 3
+			} else if score = shared.CompareNames(what.Name(), album.Name()); score > 0 {
+				score += artistsScore(what.Artists(), album.artists)
+			}
+			found = append(found, scored[shared.RemoteAlbum]{item: album, score: score})
+		}
+	}
+	return best(found), nil
+}
+
+func (e Actions) SearchArtists(ctx context.Context, what shared.RemoteArtist) ([10]shared.RemoteArtist, error) {
+	found := []scored[shared.RemoteArtist]{}
+	for _, lib := range e.libs {
+		for _, artist := range lib.artists {
+			score := shared.CompareNames(what.Name(), artist.Name())
+			found = append(found, scored[shared.RemoteArtist]{item: artist, score: score})
+		}
+	}
+	return best(found), nil
+}
+
+func (e Actions) SearchTracks(ctx context.Context, what shared.RemoteTrack) ([10]shared.RemoteTrack, error) {
+	found := []scored[shared.RemoteTrack]{}
+	for _, lib := range e.libs {
+		for _, track := range lib.tracks {
+			score := 0.0
+			if sameCode(what.ISRC(), track.isrc) {
+				score = 3
+			} else if score = shared.CompareNames(what.Name(), track.Name()); score > 0 {
+				score += artistsScore(what.Artists(), track.artists)
+			}
+			found = append(found, scored[shared.RemoteTrack]{item: track, score: score})
+		}
+	}
+	return best(found), nil
+}
+
+// Search result with relevance.
+type scored[T any] struct {
+	item  T
+	score float64
+}
+
+// Most relevant results. Zero score = not found.
+func best[T any](found []scored[T]) [10]T {
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].score > found[j].score
+	})
+	result := [10]T{}
+	for i := 0; i < len(found) && i < len(result); i++ {
+		if found[i].score <= 0 {
+			break
+		}
+		result[i] = found[i].item
+	}
+	return result
+}
+
+// 1 if one of wanted artists is same as one of artists.
+func artistsScore(wanted []shared.RemoteArtist, artists []*Artist) float64 {
+	for _, want := range wanted {
+		for _, artist := range artists {
+			if shared.CompareNames(want.Name(), artist.Name()) >= 0.8 {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// ISRC, UPC, EAN.
+func sameCode(code1, code2 *string) bool {
+	if code1 == nil || code2 == nil || len(*code1) == 0 {
+		return false
+	}
+	return strings.EqualFold(*code1, *code2)
+}
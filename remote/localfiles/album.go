@@ -0,0 +1,59 @@
+package localfiles
+
+import (
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Album artist + album name.
+func albumID(artist, name string) string {
+	return artist + " - " + name
+}
+
+type Album struct {
+	*Entity
+	barcode    string
+	artists    []*Artist
+	tracks     []*Track
+	trackTotal int
+	year       int
+}
+
+func (e Album) UPC() *string {
+	if len(e.barcode) != 12 {
+		return nil
+	}
+	return &e.barcode
+}
+
+func (e Album) EAN() *string {
+	if len(e.barcode) != 13 {
+		return nil
+	}
+	return &e.barcode
+}
+
+func (e Album) Artists() []shared.RemoteArtist {
+	result := make([]shared.RemoteArtist, len(e.artists))
+	for i := range e.artists {
+		result[i] = e.artists[i]
+	}
+	return result
+}
+
+// Track total from tags, or tracks on disk.
+func (e Album) TrackCount() int {
+	if e.trackTotal > 0 {
+		return e.trackTotal
+	}
+	return len(e.tracks)
+}
+
+func (e Album) Year() int {
+	return e.year
+}
+
+func (e Album) CoverURL() *url.URL {
+	return nil
+}
@@ -0,0 +1,40 @@
+package localfiles
+
+import (
+	"context"
+	"sort"
+)
+
+// Albums with so many tracks or less are singles.
+const _singleMaxTracks = 3
+
+type Artist struct {
+	*Entity
+	albums []*Album
+}
+
+func (e Artist) OldestAlbumsNames(ctx context.Context) ([20]string, error) {
+	return e.oldestNames(false), nil
+}
+
+func (e Artist) OldestSinglesNames(ctx context.Context) ([20]string, error) {
+	return e.oldestNames(true), nil
+}
+
+func (e Artist) oldestNames(singles bool) [20]string {
+	albums := []*Album{}
+	for _, album := range e.albums {
+		if (album.TrackCount() <= _singleMaxTracks) == singles {
+			albums = append(albums, album)
+		}
+	}
+	sort.SliceStable(albums, func(i, j int) bool {
+		return albums[i].year < albums[j].year
+	})
+
+	result := [20]string{}
+	for i := 0; i < len(albums) && i < len(result); i++ {
+		result[i] = albums[i].Name()
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package localfiles
+
+import (
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"sync"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+var (
+	// Scanned libraries by directory.
+	_libraries   = map[string]*library{}
+	_librariesMu sync.Mutex
+)
+
+// Get library of directory. Directory scanned once.
+func getLibrary(dir string) (*library, error) {
+	_librariesMu.Lock()
+	defer _librariesMu.Unlock()
+
+	if lib, ok := _libraries[dir]; ok {
+		return lib, nil
+	}
+	lib, err := scanLibrary(dir)
+	if err != nil {
+		return nil, err
+	}
+	_libraries[dir] = lib
+	return lib, err
+}
+
+// Audio files of directory.
+type library struct {
+	dir string
+
+	tracks  []*Track
+	albums  []*Album
+	artists []*Artist
+
+	tracksByID  map[shared.RemoteID]*Track
+	albumsByID  map[shared.RemoteID]*Album
+	artistsByID map[shared.RemoteID]*Artist
+}
+
+func scanLibrary(dir string) (*library, error) {
+	slog.Info("Scanning local files", "dir", dir)
+
+	lib := &library{
+		dir:         dir,
+		tracksByID:  map[shared.RemoteID]*Track{},
+		albumsByID:  map[shared.RemoteID]*Album{},
+		artistsByID: map[shared.RemoteID]*Artist{},
+	}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isAudio(path) {
+			return nil
+		}
+		info, err := readTrackInfo(path)
+		if err != nil {
+			// Broken file shouldn't break all library.
+			slog.Warn("Read tags. Skip", "path", path, "error", err.Error())
+			return nil
+		}
+		lib.add(info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Scanned local files", "dir", dir, "tracks", len(lib.tracks), "albums", len(lib.albums))
+	return lib, err
+}
+
+func (e *library) add(info *trackInfo) {
+	track := &Track{
+		Entity:   newEntity(pathToID(info.path).String(), info.title),
+		lengthMs: info.lengthMs,
+		year:     info.year,
+	}
+	if len(info.isrc) > 0 {
+		track.isrc = &info.isrc
+	}
+	for _, name := range info.artists {
+		track.artists = append(track.artists, e.artist(name))
+	}
+
+	if len(info.album) > 0 {
+		album := e.album(info.albumArtist, info.album)
+		album.tracks = append(album.tracks, track)
+		if album.trackTotal < info.trackTotal {
+			album.trackTotal = info.trackTotal
+		}
+		if len(album.barcode) == 0 {
+			album.barcode = info.barcode
+		}
+		// Oldest year of album tracks.
+		if info.year > 0 && (album.year == 0 || info.year < album.year) {
+			album.year = info.year
+		}
+		track.album = album
+	}
+
+	e.tracks = append(e.tracks, track)
+	e.tracksByID[track.ID()] = track
+}
+
+// Get or create album.
+func (e *library) album(artistName, name string) *Album {
+	id := shared.RemoteID(albumID(artistName, name))
+	if album, ok := e.albumsByID[id]; ok {
+		return album
+	}
+	album := &Album{
+		Entity: newEntity(id.String(), name),
+	}
+	for _, name := range splitArtists(artistName) {
+		artist := e.artist(name)
+		artist.albums = append(artist.albums, album)
+		album.artists = append(album.artists, artist)
+	}
+	e.albums = append(e.albums, album)
+	e.albumsByID[id] = album
+	return album
+}
+
+// Get or create artist.
+func (e *library) artist(name string) *Artist {
+	id := shared.RemoteID(name)
+	if artist, ok := e.artistsByID[id]; ok {
+		return artist
+	}
+	artist := &Artist{
+		Entity: newEntity(name, name),
+	}
+	e.artists = append(e.artists, artist)
+	e.artistsByID[id] = artist
+	return artist
+}
@@ -0,0 +1,76 @@
+package localfiles
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/oklookat/synchro/archive"
+	"github.com/oklookat/synchro/playlistfile"
+)
+
+// M3U / M3U8 playlist file.
+type m3uFile struct {
+	// #PLAYLIST.
+	name string
+
+	// Absolute paths.
+	paths []string
+}
+
+func readM3U(path string) (*m3uFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	pl, err := playlistfile.Read(file, playlistfile.FormatM3U8)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &m3uFile{name: pl.Name}
+	base := filepath.Dir(path)
+	for _, track := range pl.Tracks {
+		trackPath := filepath.FromSlash(track.Location)
+		if !filepath.IsAbs(trackPath) {
+			trackPath = filepath.Join(base, trackPath)
+		}
+		result.paths = append(result.paths, trackPath)
+	}
+
+	return result, err
+}
+
+// Write playlist. Paths relative to playlist file, if possible.
+//
+// Library tracks used for metadata.
+func writeM3U(path string, pl *m3uFile, lib *library) error {
+	base := filepath.Dir(path)
+
+	converted := playlistfile.Playlist{Name: pl.name}
+	for _, trackPath := range pl.paths {
+		entry := playlistfile.Track{}
+		if track, ok := lib.tracksByID[pathToID(trackPath)]; ok {
+			entry.Track = archive.NewTrack(track)
+		}
+		if rel, err := filepath.Rel(base, trackPath); err == nil {
+			trackPath = rel
+		}
+		entry.Location = filepath.ToSlash(trackPath)
+		converted.Tracks = append(converted.Tracks, entry)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := playlistfile.Write(buf, playlistfile.FormatM3U8, converted); err != nil {
+		return err
+	}
+
+	// Write to temp file first, so playlist will not be broken on error.
+	temp := path + ".tmp"
+	if err := os.WriteFile(temp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
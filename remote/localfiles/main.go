@@ -0,0 +1,42 @@
+package localfiles
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Dir: music directory. Stored as account auth.
+func NewAccount(ctx context.Context, alias string, dir string) (shared.Account, error) {
+	dir, err := checkDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := _repo.CreateAccount(alias, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, err
+}
+
+// Absolute path of existing directory.
+func checkDir(dir string) (string, error) {
+	dir, err := filepath.Abs(strings.TrimSpace(dir))
+	if err != nil {
+		return "", err
+	}
+	stat, err := os.Stat(dir)
+	if err != nil {
+		return "", err
+	}
+	if !stat.IsDir() {
+		return "", errors.New("not a directory: " + dir)
+	}
+	return dir, err
+}
@@ -0,0 +1,126 @@
+package localfiles
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Playlists extension.
+const _playlistExt = ".m3u8"
+
+func newPlaylist(account shared.Account, lib *library, path string, file *m3uFile) *Playlist {
+	name := file.name
+	if len(name) == 0 {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return &Playlist{
+		Entity:  newEntity(pathToID(path).String(), name),
+		account: account,
+		lib:     lib,
+		path:    path,
+		file:    file,
+	}
+}
+
+// M3U8 file.
+type Playlist struct {
+	*Entity
+	account shared.Account
+	lib     *library
+	path    string
+	file    *m3uFile
+}
+
+func (e Playlist) FromAccount() shared.Account {
+	return e.account
+}
+
+func (e Playlist) Description() *string {
+	return nil
+}
+
+func (e Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
+	return e.lib.tracksOf(e.file), nil
+}
+
+func (e *Playlist) Rename(ctx context.Context, newName string) error {
+	e.file.name = newName
+	if err := e.save(); err != nil {
+		return err
+	}
+	e.name = newName
+	return nil
+}
+
+func (e *Playlist) SetDescription(ctx context.Context, newDesc string) error {
+	return shared.ErrNotImplemented
+}
+
+func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
+	for _, id := range ids {
+		if len(id) == 0 {
+			continue
+		}
+		e.file.paths = append(e.file.paths, idToPath(id))
+	}
+	return e.save()
+}
+
+func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) error {
+	e.file.paths = removePaths(e.file.paths, ids)
+	return e.save()
+}
+
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	paths := make([]string, 0, len(ids))
+	for _, id := range ids {
+		paths = append(paths, idToPath(id))
+	}
+	e.file.paths = paths
+	return e.save()
+}
+
+func (e Playlist) IsVisible() (bool, error) {
+	return false, shared.ErrNotImplemented
+}
+
+func (e *Playlist) SetIsVisible(ctx context.Context, val bool) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) save() error {
+	return writeM3U(e.path, e.file, e.lib)
+}
+
+// Playlist tracks from library. Tracks not in library skipped.
+func (e *library) tracksOf(file *m3uFile) []shared.RemoteTrack {
+	result := []shared.RemoteTrack{}
+	for _, path := range file.paths {
+		track, ok := e.tracksByID[pathToID(path)]
+		if !ok {
+			slog.Warn("Not in library. Skip", "path", path)
+			continue
+		}
+		result = append(result, track)
+	}
+	return result
+}
+
+// Paths without ids.
+func removePaths(paths []string, ids []shared.RemoteID) []string {
+	toRemove := make(map[shared.RemoteID]bool, len(ids))
+	for _, id := range ids {
+		toRemove[id] = true
+	}
+	result := []string{}
+	for _, path := range paths {
+		if !toRemove[pathToID(path)] {
+			result = append(result, path)
+		}
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package localfiles
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+var (
+	_repo shared.RemoteRepository
+)
+
+const (
+	RemoteName shared.RemoteName = "LocalFiles"
+)
+
+type Remote struct {
+}
+
+func (s *Remote) Boot(repo shared.RemoteRepository) error {
+	_repo = repo
+	return nil
+}
+
+func (s Remote) Name() shared.RemoteName {
+	return RemoteName
+}
+
+func (s Remote) Repository() shared.RemoteRepository {
+	return _repo
+}
+
+func (s Remote) AssignAccountActions(account shared.Account) (shared.AccountActions, error) {
+	return newAccountActions(account)
+}
+
+func (s Remote) Actions() (shared.RemoteActions, error) {
+	accounts, err := _repo.Accounts(context.Background())
+	if err != nil || len(accounts) == 0 {
+		return nil, err
+	}
+
+	// Search in all directories.
+	var libs []*library
+	for i := range accounts {
+		lib, err := getLibrary(accounts[i].Auth())
+		if err != nil {
+			slog.Error("getLibrary: " + err.Error())
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	if len(libs) == 0 {
+		return nil, shared.ErrNoRemoteActions
+	}
+
+	return newActions(libs), nil
+}
+
+// Tracks and playlists IDs are file paths.
+func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
+	if etype != shared.EntityTypeTrack && etype != shared.EntityTypePlaylist {
+		return url.URL{}
+	}
+	// Windows: "C:/Music" -> "/C:/Music".
+	return url.URL{Scheme: "file", Path: "/" + strings.TrimPrefix(id.String(), "/")}
+}
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// LocalFiles: no network, only disk.
+	return shared.RemoteLimits{
+		Parallelism: 4,
+		Rate:        1000,
+	}
+}
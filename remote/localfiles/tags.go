@@ -0,0 +1,329 @@
+package localfiles
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dhowden/tag"
+)
+
+var (
+	errAtomNotFound = errors.New("atom not found")
+	errInvalidAudio = errors.New("invalid audio")
+)
+
+// Supported extensions.
+var _extensions = map[string]bool{
+	".flac": true,
+	".mp3":  true,
+	".m4a":  true,
+}
+
+func isAudio(path string) bool {
+	return _extensions[strings.ToLower(filepath.Ext(path))]
+}
+
+// Tags of audio file.
+type trackInfo struct {
+	path        string
+	title       string
+	album       string
+	albumArtist string
+	artists     []string
+	isrc        string
+	barcode     string
+	year        int
+	trackTotal  int
+	lengthMs    int
+}
+
+func readTrackInfo(path string) (*trackInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	meta, err := tag.ReadFrom(file)
+	if err != nil {
+		return nil, err
+	}
+	raw := meta.Raw()
+
+	info := &trackInfo{
+		path:        path,
+		title:       strings.TrimSpace(meta.Title()),
+		album:       strings.TrimSpace(meta.Album()),
+		albumArtist: strings.TrimSpace(meta.AlbumArtist()),
+		artists:     splitArtists(meta.Artist()),
+		isrc:        rawString(raw, "TSRC", "TRC", "ISRC"),
+		barcode:     rawString(raw, "BARCODE", "UPC", "EAN"),
+		year:        meta.Year(),
+	}
+	_, info.trackTotal = meta.Track()
+	if len(info.title) == 0 {
+		info.title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if len(info.albumArtist) == 0 && len(info.artists) > 0 {
+		info.albumArtist = info.artists[0]
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".flac":
+		info.lengthMs, err = flacLengthMs(file)
+	case ".m4a":
+		info.lengthMs, err = mp4LengthMs(file)
+	case ".mp3":
+		// ID3 TLEN, or calculate from frames.
+		info.lengthMs, _ = strconv.Atoi(rawString(raw, "TLEN", "TLE"))
+		if info.lengthMs == 0 {
+			info.lengthMs, err = mp3LengthMs(file)
+		}
+	}
+	// Length is optional.
+	if err != nil {
+		info.lengthMs = 0
+	}
+
+	return info, nil
+}
+
+// Multiple artists separated by ";".
+func splitArtists(val string) []string {
+	result := []string{}
+	for _, artist := range strings.Split(val, ";") {
+		artist = strings.TrimSpace(artist)
+		if len(artist) > 0 {
+			result = append(result, artist)
+		}
+	}
+	return result
+}
+
+// Get first not empty string value of raw tag. Keys case insensitive.
+func rawString(raw map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		for rawKey, val := range raw {
+			if !strings.EqualFold(rawKey, key) {
+				continue
+			}
+			str, ok := val.(string)
+			if !ok {
+				continue
+			}
+			if str = strings.TrimSpace(str); len(str) > 0 {
+				return str
+			}
+		}
+	}
+	return ""
+}
+
+// Length from STREAMINFO block.
+//
+// https://xiph.org/flac/format.html#metadata_block_streaminfo
+func flacLengthMs(r io.Reader) (int, error) {
+	// "fLaC" + block header + STREAMINFO.
+	buf := make([]byte, 4+4+34)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, err
+	}
+	if string(buf[:4]) != "fLaC" || buf[4]&0x7F != 0 {
+		return 0, errInvalidAudio
+	}
+	info := buf[8:]
+	sampleRate := uint64(info[10])<<12 | uint64(info[11])<<4 | uint64(info[12])>>4
+	samples := uint64(info[13]&0x0F)<<32 | uint64(binary.BigEndian.Uint32(info[14:18]))
+	if sampleRate == 0 {
+		return 0, errInvalidAudio
+	}
+	return int(samples * 1000 / sampleRate), nil
+}
+
+// Length from moov/mvhd atom.
+func mp4LengthMs(r io.ReadSeeker) (int, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	moovStart, moovEnd, err := findAtom(r, 0, end, "moov")
+	if err != nil {
+		return 0, err
+	}
+	mvhdStart, _, err := findAtom(r, moovStart, moovEnd, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+	if _, err = r.Seek(mvhdStart, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	// Version + flags + creation time + modification time + timescale + duration.
+	buf := make([]byte, 4+8+8+4+8)
+	if _, err = io.ReadFull(r, buf[:4+4+4+4+4]); err != nil {
+		return 0, err
+	}
+	var timescale, duration uint64
+	if buf[0] == 1 {
+		if _, err = io.ReadFull(r, buf[20:]); err != nil {
+			return 0, err
+		}
+		timescale = uint64(binary.BigEndian.Uint32(buf[20:24]))
+		duration = binary.BigEndian.Uint64(buf[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(buf[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(buf[16:20]))
+	}
+	if timescale == 0 {
+		return 0, errInvalidAudio
+	}
+	return int(duration * 1000 / timescale), nil
+}
+
+// Find atom between start and end. Returns atom content start and atom end.
+func findAtom(r io.ReadSeeker, start, end int64, name string) (int64, int64, error) {
+	header := make([]byte, 8)
+	for pos := start; pos+8 <= end; {
+		if _, err := r.Seek(pos, io.SeekStart); err != nil {
+			return 0, 0, err
+		}
+		if _, err := io.ReadFull(r, header); err != nil {
+			return 0, 0, err
+		}
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			// Atom extends to end.
+			size = end - pos
+		case 1:
+			// 64-bit size.
+			if _, err := io.ReadFull(r, header); err != nil {
+				return 0, 0, err
+			}
+			size = int64(binary.BigEndian.Uint64(header))
+			headerSize = 16
+		}
+		if size < headerSize {
+			return 0, 0, errInvalidAudio
+		}
+		if string(header[4:8]) == name {
+			return pos + headerSize, pos + size, nil
+		}
+		pos += size
+	}
+	return 0, 0, errAtomNotFound
+}
+
+var (
+	// Layer III bitrates (kbps).
+	_mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	_mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+
+	// MPEG1 sample rates. MPEG2: /2, MPEG2.5: /4.
+	_mp3SampleRates = [4]int{44100, 48000, 32000, 0}
+)
+
+// Length from Xing/Info header (VBR), or from bitrate of first frame (CBR).
+func mp3LengthMs(r io.ReadSeeker) (int, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	// Skip ID3v2.
+	var audioStart int64
+	header := make([]byte, 10)
+	if _, err = io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	if string(header[:3]) == "ID3" {
+		// Syncsafe integer.
+		tagSize := int64(header[6])<<21 | int64(header[7])<<14 | int64(header[8])<<7 | int64(header[9])
+		audioStart = 10 + tagSize
+		// Footer.
+		if header[5]&0x10 != 0 {
+			audioStart += 10
+		}
+	}
+	if _, err = r.Seek(audioStart, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	// First frame (and maybe Xing header) should be near.
+	buf := make([]byte, 64*1024)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, err
+	}
+	buf = buf[:n]
+
+	for i := 0; i+4 <= len(buf); i++ {
+		if buf[i] != 0xFF || buf[i+1]&0xE0 != 0xE0 {
+			continue
+		}
+		version := (buf[i+1] >> 3) & 0x03
+		layer := (buf[i+1] >> 1) & 0x03
+		bitrateIndex := buf[i+2] >> 4
+		sampleRateIndex := (buf[i+2] >> 2) & 0x03
+		mono := buf[i+3]>>6 == 3
+		// Layer III only.
+		if version == 1 || layer != 1 {
+			continue
+		}
+
+		isV1 := version == 3
+		bitrate := _mp3BitratesV2[bitrateIndex]
+		sampleRate := _mp3SampleRates[sampleRateIndex]
+		samplesPerFrame := 576
+		xingOffset := 4 + 17
+		if isV1 {
+			bitrate = _mp3BitratesV1[bitrateIndex]
+			samplesPerFrame = 1152
+			xingOffset = 4 + 32
+			if mono {
+				xingOffset = 4 + 17
+			}
+		} else {
+			sampleRate /= 2
+			if version == 0 {
+				// MPEG2.5.
+				sampleRate /= 2
+			}
+			if mono {
+				xingOffset = 4 + 9
+			}
+		}
+		if bitrate == 0 || sampleRate == 0 {
+			continue
+		}
+
+		// Xing / Info with frames count.
+		xing := i + xingOffset
+		if xing+12 <= len(buf) {
+			id := string(buf[xing : xing+4])
+			flags := binary.BigEndian.Uint32(buf[xing+4 : xing+8])
+			if (id == "Xing" || id == "Info") && flags&0x01 != 0 {
+				frames := int64(binary.BigEndian.Uint32(buf[xing+8 : xing+12]))
+				return int(frames * int64(samplesPerFrame) * 1000 / int64(sampleRate)), nil
+			}
+		}
+
+		// CBR.
+		audioSize := size - audioStart - int64(i)
+		return int(audioSize * 8 / int64(bitrate)), nil
+	}
+
+	return 0, errInvalidAudio
+}
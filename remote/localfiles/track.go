@@ -0,0 +1,47 @@
+package localfiles
+
+import (
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+type Track struct {
+	*Entity
+	isrc     *string
+	artists  []*Artist
+	album    *Album
+	lengthMs int
+	year     int
+}
+
+func (e Track) ISRC() *string {
+	return e.isrc
+}
+
+func (e Track) Artists() []shared.RemoteArtist {
+	result := make([]shared.RemoteArtist, len(e.artists))
+	for i := range e.artists {
+		result[i] = e.artists[i]
+	}
+	return result
+}
+
+func (e Track) Album() (shared.RemoteAlbum, error) {
+	if e.album == nil {
+		return nil, nil
+	}
+	return e.album, nil
+}
+
+func (e Track) LengthMs() int {
+	return e.lengthMs
+}
+
+func (e Track) Year() int {
+	return e.year
+}
+
+func (e Track) CoverURL() *url.URL {
+	return nil
+}
@@ -0,0 +1,61 @@
+package localfiles
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+func newEntity(id, name string) *Entity {
+	return &Entity{
+		id:   id,
+		name: name,
+	}
+}
+
+type Entity struct {
+	id   string
+	name string
+}
+
+func (e Entity) RemoteName() shared.RemoteName {
+	return RemoteName
+}
+
+func (e Entity) ID() shared.RemoteID {
+	return shared.RemoteID(e.id)
+}
+
+func (e Entity) Name() string {
+	return e.name
+}
+
+// File path to ID (absolute, slash separated).
+func pathToID(path string) shared.RemoteID {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	return shared.RemoteID(filepath.ToSlash(abs))
+}
+
+// ID to file path.
+func idToPath(id shared.RemoteID) string {
+	return filepath.FromSlash(id.String())
+}
+
+// Make name usable as file name.
+func safeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`<>:"/\|?*`, r) || r < 32 {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " .")
+	if len(name) == 0 {
+		return "Playlist"
+	}
+	return name
+}
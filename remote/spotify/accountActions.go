@@ -2,6 +2,7 @@ package spotify
 
 import (
 	"context"
+	"time"
 
 	"github.com/oklookat/synchro/shared"
 	"github.com/zmb3/spotify/v2"
@@ -40,21 +41,26 @@ func (e AccountActions) Playlist() shared.PlaylistActions {
 }
 
 type LikedAlbumsActions struct {
-	client *spotify.Client
+	client  *spotify.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	albums := []shared.RemoteEntity{}
 	offset := 0
+	e.likedAt = shared.LikedDates{}
 
 	for {
 		albumsd, err := e.client.CurrentUsersAlbums(ctx, spotify.Limit(45), spotify.Offset(offset))
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		for i := range albumsd.Albums {
 			albums = append(albums, newAlbum(&albumsd.Albums[i].FullAlbum, e.client))
+			if at, err := time.Parse(spotify.TimestampLayout, albumsd.Albums[i].AddedAt); err == nil {
+				e.likedAt[shared.RemoteID(albumsd.Albums[i].ID)] = at
+			}
 		}
 
 		if len(albums) >= int(albumsd.Total) {
@@ -67,6 +73,10 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return albums, nil
 }
 
+func (e LikedAlbumsActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
+}
+
 func (e LikedAlbumsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -96,7 +106,7 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 
 		followed, err := e.client.CurrentUsersFollowedArtists(ctx, options...)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		for i := range followed.Artists {
@@ -113,6 +123,10 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 	return artists, nil
 }
 
+func (e LikedArtistsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
 func (e LikedArtistsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -126,21 +140,26 @@ func (e LikedArtistsActions) likeUnlike(ctx context.Context, ids []shared.Remote
 }
 
 type LikedTracksActions struct {
-	client *spotify.Client
+	client  *spotify.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	tracks := []shared.RemoteEntity{}
 	offset := 0
+	e.likedAt = shared.LikedDates{}
 
 	for {
 		currentUser, err := e.client.CurrentUsersTracks(ctx, spotify.Limit(45), spotify.Offset(offset))
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		for i := range currentUser.Tracks {
 			tracks = append(tracks, newTrack(currentUser.Tracks[i].FullTrack, e.client))
+			if at, err := time.Parse(spotify.TimestampLayout, currentUser.Tracks[i].AddedAt); err == nil {
+				e.likedAt[shared.RemoteID(currentUser.Tracks[i].ID)] = at
+			}
 		}
 
 		if len(tracks) >= int(currentUser.Total) || len(currentUser.Next) == 0 {
@@ -153,6 +172,10 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return tracks, nil
 }
 
+func (e LikedTracksActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
+}
+
 func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -183,12 +206,12 @@ func likeUnlike(
 	for i := range idsChunked {
 		if like {
 			if err := liker(ctx, idsChunked[i]...); err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		if err := unliker(ctx, idsChunked[i]...); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -203,7 +226,7 @@ type PlaylistActions struct {
 
 func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlaylist, error) {
 	if err := e.cache(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	result := []shared.RemotePlaylist{}
@@ -218,7 +241,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 			spotify.Limit(limit),
 			spotify.Offset(offset))
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		for _, item := range page.Playlists {
 			if item.Collaborative {
@@ -240,7 +263,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 
 func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
 	if err := e.cache(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	desc := ""
@@ -250,7 +273,7 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 
 	pl, err := e.client.CreatePlaylistForUser(ctx, e.currentUser.ID, name, desc, isVisible, false)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	return newPlaylist(e.account, pl.SimplePlaylist, e.client), err
@@ -259,7 +282,7 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 func (e PlaylistActions) Delete(ctx context.Context, entities []shared.RemoteID) error {
 	for _, id := range entities {
 		if err := e.client.UnfollowPlaylist(ctx, spotify.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -271,7 +294,7 @@ func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shar
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newPlaylist(e.account, pl.SimplePlaylist, e.client), err
 }
@@ -282,8 +305,8 @@ func (e *PlaylistActions) cache(ctx context.Context) error {
 	}
 	usr, err := e.client.CurrentUser(ctx)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 	e.currentUser = usr
-	return err
+	return wrapErr(err)
 }
@@ -26,7 +26,7 @@ func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAl
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newAlbum(album, e.client), err
 }
@@ -37,7 +37,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return newArtist(artist.SimpleArtist, e.client), err
 }
@@ -48,7 +48,7 @@ func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTr
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if track == nil {
 		return nil, nil
@@ -82,7 +82,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 
 	search, err := pleaseSearch(ctx, e.client, query, spotify.SearchTypeAlbum, spotify.Limit(10), spotify.Offset(0), _market)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 	if search.Albums == nil || len(search.Albums.Albums) == 0 {
 		return result, nil
@@ -98,7 +98,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 
 	fullAlbums, err := e.client.GetAlbums(ctx, albumsIds[:], _market)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 	for i := range result {
 		if i == len(fullAlbums) {
@@ -110,7 +110,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 		result[i] = newAlbum(fullAlbums[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type ArtistsSearchAction struct {
@@ -125,7 +125,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 	search, err := pleaseSearch(ctx, e.client, query, spotify.SearchTypeArtist,
 		spotify.Limit(10), spotify.Offset(0), _market)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 	if search.Artists == nil || len(search.Artists.Artists) == 0 {
 		return result, nil
@@ -138,7 +138,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		result[i] = newArtist(search.Artists.Artists[i].SimpleArtist, e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type TracksSearchAction struct {
@@ -155,7 +155,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		if isNotFound(err) {
 			return result, nil
 		}
-		return result, err
+		return result, wrapErr(err)
 	}
 	if search.Tracks == nil || len(search.Tracks.Tracks) == 0 {
 		return result, nil
@@ -168,5 +168,5 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		result[i] = newTrack(search.Tracks.Tracks[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
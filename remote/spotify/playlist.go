@@ -38,7 +38,7 @@ func (e Playlist) Description() *string {
 
 func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	if err := e.cacheTracks(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(e.cachedTracks) == 0 {
 		return nil, nil
@@ -58,7 +58,7 @@ func (e *Playlist) Rename(ctx context.Context, newName string) error {
 	if err == nil {
 		e.playlist.Name = newName
 	}
-	return err
+	return wrapErr(err)
 }
 
 func (e Playlist) SetDescription(ctx context.Context, newDesc string) error {
@@ -71,7 +71,7 @@ func (e *Playlist) IsVisible() (bool, error) {
 
 func (e *Playlist) SetIsVisible(ctx context.Context, val bool) error {
 	if err := e.client.ChangePlaylistAccess(ctx, e.playlist.ID, val); err != nil {
-		return err
+		return wrapErr(err)
 	}
 	e.playlist.IsPublic = val
 	return nil
@@ -85,6 +85,34 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	return e.addRemoveTracks(ctx, ids, false)
 }
 
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	e.cachedTracks = nil
+
+	var converted []spotify.ID
+	for _, id := range ids {
+		converted = append(converted, spotify.ID(id))
+	}
+
+	// Replace: 100 items max. Rest will be added.
+	first := converted
+	if len(first) > 100 {
+		first = first[:100]
+	}
+	if err := e.client.ReplacePlaylistTracks(ctx, e.playlist.ID, first...); err != nil {
+		return wrapErr(err)
+	}
+
+	for _, chunk := range shared.ChunkSlice(converted[len(first):], 80) {
+		snapshotID, err := e.client.AddTracksToPlaylist(ctx, e.playlist.ID, chunk...)
+		if err != nil {
+			return wrapErr(err)
+		}
+		e.snapshotID = snapshotID
+	}
+
+	return nil
+}
+
 func (e *Playlist) addRemoveTracks(ctx context.Context, ids []shared.RemoteID, add bool) error {
 	e.cachedTracks = nil
 
@@ -104,7 +132,7 @@ func (e *Playlist) addRemoveTracks(ctx context.Context, ids []shared.RemoteID, a
 			snapshotID, err = e.client.RemoveTracksFromPlaylist(ctx, e.playlist.ID, idsChunked[i]...)
 		}
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		e.snapshotID = snapshotID
 	}
@@ -125,7 +153,7 @@ func (e *Playlist) cacheTracks(ctx context.Context) error {
 	for {
 		page, err := e.client.GetPlaylistItems(ctx, e.playlist.ID, spotify.Limit(limit), spotify.Offset(offset))
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 
 		for _, item := range page.Items {
@@ -63,3 +63,11 @@ func (s Remote) Actions() (shared.RemoteActions, error) {
 func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
 	return shared.GetEntityURL("http://open.spotify.com", etype, id)
 }
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// Spotify: rolling 30 seconds window, limit not published.
+	return shared.RemoteLimits{
+		Parallelism: 4,
+		Rate:        10,
+	}
+}
@@ -99,3 +99,16 @@ func isNotFound(err error) bool {
 	spotErr := &spotify.Error{}
 	return errors.As(err, spotErr) && spotErr.Status == 404
 }
+
+// Convert error to shared.ErrRemote.
+func wrapErr(err error) error {
+	if err == nil || shared.ErrorKindOf(err) != shared.ErrorKindUnknown {
+		return err
+	}
+	kind := shared.ErrorKindUnknown
+	spotErr := &spotify.Error{}
+	if errors.As(err, spotErr) {
+		kind = shared.ErrorKindFromStatus(spotErr.Status)
+	}
+	return shared.NewErrRemote(_repo.Name(), kind, err)
+}
@@ -0,0 +1,108 @@
+package spotifyexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+func newAccountActions(account shared.Account) (*AccountActions, error) {
+	exp, err := getExport(account.Auth())
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActions{
+		account: account,
+		exp:     exp,
+	}, err
+}
+
+// Read-only.
+type AccountActions struct {
+	account shared.Account
+	exp     *export
+}
+
+func (e AccountActions) LikedAlbums() shared.LikedActions {
+	result := make([]shared.RemoteEntity, len(e.exp.likedAlbums))
+	for i := range e.exp.likedAlbums {
+		result[i] = e.exp.likedAlbums[i]
+	}
+	return &LikedActions{liked: result}
+}
+
+func (e AccountActions) LikedArtists() shared.LikedActions {
+	result := make([]shared.RemoteEntity, len(e.exp.likedArtists))
+	for i := range e.exp.likedArtists {
+		result[i] = e.exp.likedArtists[i]
+	}
+	return &LikedActions{liked: result}
+}
+
+func (e AccountActions) LikedTracks() shared.LikedActions {
+	result := make([]shared.RemoteEntity, len(e.exp.likedTracks))
+	for i := range e.exp.likedTracks {
+		result[i] = e.exp.likedTracks[i]
+	}
+	return &LikedActions{liked: result}
+}
+
+func (e AccountActions) Playlist() shared.PlaylistActions {
+	return &PlaylistActions{
+		account: e.account,
+		exp:     e.exp,
+	}
+}
+
+type LikedActions struct {
+	liked []shared.RemoteEntity
+}
+
+func (e LikedActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+	return e.liked, nil
+}
+
+// Export doesn't provide like dates.
+func (e LikedActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
+func (e LikedActions) Like(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e LikedActions) Unlike(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+type PlaylistActions struct {
+	account shared.Account
+	exp     *export
+}
+
+func (e PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlaylist, error) {
+	result := make([]shared.RemotePlaylist, len(e.exp.playlists))
+	for i := range e.exp.playlists {
+		result[i] = newPlaylist(e.account, e.exp.playlists[i])
+	}
+	return result, nil
+}
+
+func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
+	return nil, shared.ErrNotImplemented
+}
+
+func (e PlaylistActions) Delete(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shared.RemotePlaylist, error) {
+	for i := range e.exp.playlists {
+		if e.exp.playlists[i].id == id.String() {
+			return newPlaylist(e.account, e.exp.playlists[i]), nil
+		}
+	}
+	// Not found.
+	return nil, nil
+}
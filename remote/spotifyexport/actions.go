@@ -0,0 +1,57 @@
+package spotifyexport
+
+import (
+	"context"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+func newActions(exps []*export) *Actions {
+	return &Actions{
+		exps: exps,
+	}
+}
+
+// Entities from exports of all accounts. No search.
+type Actions struct {
+	exps []*export
+}
+
+func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAlbum, error) {
+	for _, exp := range e.exps {
+		if album, ok := exp.albumsByID[id]; ok {
+			return album, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteArtist, error) {
+	for _, exp := range e.exps {
+		if artist, ok := exp.artistsByID[id]; ok {
+			return artist, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTrack, error) {
+	for _, exp := range e.exps {
+		if track, ok := exp.tracksByID[id]; ok {
+			return track, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e Actions) SearchAlbums(ctx context.Context, what shared.RemoteAlbum) ([10]shared.RemoteAlbum, error) {
+	return [10]shared.RemoteAlbum{}, shared.ErrNotImplemented
+}
+
+func (e Actions) SearchArtists(ctx context.Context, what shared.RemoteArtist) ([10]shared.RemoteArtist, error) {
+	return [10]shared.RemoteArtist{}, shared.ErrNotImplemented
+}
+
+func (e Actions) SearchTracks(ctx context.Context, what shared.RemoteTrack) ([10]shared.RemoteTrack, error) {
+	return [10]shared.RemoteTrack{}, shared.ErrNotImplemented
+}
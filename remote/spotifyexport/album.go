@@ -0,0 +1,39 @@
+package spotifyexport
+
+import (
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Only name and artist in export.
+type Album struct {
+	*Entity
+	artists []*Artist
+}
+
+func (e Album) UPC() *string {
+	return nil
+}
+
+func (e Album) EAN() *string {
+	return nil
+}
+
+func (e Album) Artists() []shared.RemoteArtist {
+	return convertArtists(e.artists)
+}
+
+// Unknown.
+func (e Album) TrackCount() int {
+	return 0
+}
+
+// Unknown.
+func (e Album) Year() int {
+	return 0
+}
+
+func (e Album) CoverURL() *url.URL {
+	return nil
+}
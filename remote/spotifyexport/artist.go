@@ -0,0 +1,35 @@
+package spotifyexport
+
+import (
+	"context"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+type Artist struct {
+	*Entity
+
+	// Albums from export (no release dates, so not sorted).
+	albums []*Album
+}
+
+func (e Artist) OldestAlbumsNames(ctx context.Context) ([20]string, error) {
+	result := [20]string{}
+	for i := 0; i < len(e.albums) && i < len(result); i++ {
+		result[i] = e.albums[i].Name()
+	}
+	return result, nil
+}
+
+// Unknown.
+func (e Artist) OldestSinglesNames(ctx context.Context) ([20]string, error) {
+	return [20]string{}, nil
+}
+
+func convertArtists(artists []*Artist) []shared.RemoteArtist {
+	result := make([]shared.RemoteArtist, len(artists))
+	for i := range artists {
+		result[i] = artists[i]
+	}
+	return result
+}
@@ -0,0 +1,244 @@
+package spotifyexport
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+const _libraryFile = "YourLibrary.json"
+
+var (
+	// Loaded exports by directory.
+	_exports   = map[string]*export{}
+	_exportsMu sync.Mutex
+)
+
+// Get export of directory. Directory loaded once.
+func getExport(dir string) (*export, error) {
+	_exportsMu.Lock()
+	defer _exportsMu.Unlock()
+
+	if exp, ok := _exports[dir]; ok {
+		return exp, nil
+	}
+	exp, err := loadExport(dir)
+	if err != nil {
+		return nil, err
+	}
+	_exports[dir] = exp
+	return exp, err
+}
+
+// YourLibrary.json.
+type yourLibrary struct {
+	Tracks []struct {
+		Artist string `json:"artist"`
+		Album  string `json:"album"`
+		Track  string `json:"track"`
+		URI    string `json:"uri"`
+	} `json:"tracks"`
+	Albums []struct {
+		Artist string `json:"artist"`
+		Album  string `json:"album"`
+		URI    string `json:"uri"`
+	} `json:"albums"`
+	Artists []struct {
+		Name string `json:"name"`
+		URI  string `json:"uri"`
+	} `json:"artists"`
+}
+
+// Playlist1.json, Playlist2.json...
+type playlistsFile struct {
+	Playlists []struct {
+		Name        string  `json:"name"`
+		Description *string `json:"description"`
+		Items       []struct {
+			// Nil for episodes and local files.
+			Track *struct {
+				TrackName  string `json:"trackName"`
+				ArtistName string `json:"artistName"`
+				AlbumName  string `json:"albumName"`
+				TrackURI   string `json:"trackUri"`
+			} `json:"track"`
+		} `json:"items"`
+	} `json:"playlists"`
+}
+
+// "Download your data" archive (unpacked).
+type export struct {
+	dir string
+
+	likedTracks  []*Track
+	likedAlbums  []*Album
+	likedArtists []*Artist
+	playlists    []*exportPlaylist
+
+	tracksByID  map[shared.RemoteID]*Track
+	albumsByID  map[shared.RemoteID]*Album
+	artistsByID map[shared.RemoteID]*Artist
+
+	// By album artist + album name.
+	albumsByName  map[string]*Album
+	artistsByName map[string]*Artist
+}
+
+type exportPlaylist struct {
+	id          string
+	name        string
+	description *string
+	tracks      []*Track
+}
+
+func loadExport(dir string) (*export, error) {
+	exp := &export{
+		dir:           dir,
+		tracksByID:    map[shared.RemoteID]*Track{},
+		albumsByID:    map[shared.RemoteID]*Album{},
+		artistsByID:   map[shared.RemoteID]*Artist{},
+		albumsByName:  map[string]*Album{},
+		artistsByName: map[string]*Artist{},
+	}
+
+	lib := yourLibrary{}
+	if err := readJSON(filepath.Join(dir, _libraryFile), &lib); err != nil {
+		return nil, err
+	}
+
+	// Albums first, so tracks and artists can use them.
+	for _, album := range lib.Albums {
+		id, ok := parseURI(album.URI, "album")
+		if !ok {
+			continue
+		}
+		exp.likedAlbums = append(exp.likedAlbums, exp.album(id, album.Artist, album.Album))
+	}
+	for _, artist := range lib.Artists {
+		id, ok := parseURI(artist.URI, "artist")
+		if !ok {
+			continue
+		}
+		liked := exp.artist(artist.Name)
+		liked.id = id.String()
+		exp.artistsByID[id] = liked
+		exp.likedArtists = append(exp.likedArtists, liked)
+	}
+	for _, track := range lib.Tracks {
+		id, ok := parseURI(track.URI, "track")
+		if !ok {
+			continue
+		}
+		exp.likedTracks = append(exp.likedTracks, exp.track(id, track.Track, track.Artist, track.Album))
+	}
+
+	// Playlists.
+	paths, err := filepath.Glob(filepath.Join(dir, "Playlist*.json"))
+	if err != nil {
+		return nil, err
+	}
+	// Playlist1, Playlist2... Playlist10.
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) < len(paths[j]) || len(paths[i]) == len(paths[j]) && paths[i] < paths[j]
+	})
+	for _, path := range paths {
+		file := playlistsFile{}
+		if err := readJSON(path, &file); err != nil {
+			return nil, err
+		}
+		for i, pl := range file.Playlists {
+			converted := &exportPlaylist{
+				// No IDs in export.
+				id:          filepath.Base(path) + "#" + strconv.Itoa(i),
+				name:        pl.Name,
+				description: pl.Description,
+			}
+			for _, item := range pl.Items {
+				if item.Track == nil {
+					continue
+				}
+				id, ok := parseURI(item.Track.TrackURI, "track")
+				if !ok {
+					continue
+				}
+				converted.tracks = append(converted.tracks, exp.track(id, item.Track.TrackName, item.Track.ArtistName, item.Track.AlbumName))
+			}
+			exp.playlists = append(exp.playlists, converted)
+		}
+	}
+
+	return exp, err
+}
+
+// Get or create track.
+func (e *export) track(id shared.RemoteID, name, artistName, albumName string) *Track {
+	if track, ok := e.tracksByID[id]; ok {
+		return track
+	}
+	track := &Track{
+		Entity:  newEntity(id.String(), name),
+		artists: []*Artist{e.artist(artistName)},
+	}
+	if len(albumName) > 0 {
+		track.album = e.album("", artistName, albumName)
+	}
+	e.tracksByID[id] = track
+	return track
+}
+
+// Get or create album. ID can be empty (album of track).
+func (e *export) album(id shared.RemoteID, artistName, name string) *Album {
+	key := albumKey(artistName, name)
+	album, ok := e.albumsByName[key]
+	if !ok {
+		artist := e.artist(artistName)
+		album = &Album{
+			Entity:  newEntity("", name),
+			artists: []*Artist{artist},
+		}
+		artist.albums = append(artist.albums, album)
+		e.albumsByName[key] = album
+	}
+	if len(id) > 0 && len(album.id) == 0 {
+		album.id = id.String()
+		e.albumsByID[id] = album
+	}
+	return album
+}
+
+// Get or create artist. Artists without URI have empty ID.
+func (e *export) artist(name string) *Artist {
+	if artist, ok := e.artistsByName[name]; ok {
+		return artist
+	}
+	artist := &Artist{Entity: newEntity("", name)}
+	e.artistsByName[name] = artist
+	return artist
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// "spotify:track:ID" -> "ID".
+func parseURI(uri string, etype string) (shared.RemoteID, bool) {
+	id, ok := strings.CutPrefix(uri, "spotify:"+etype+":")
+	if !ok || len(id) == 0 {
+		return "", false
+	}
+	return shared.RemoteID(id), true
+}
+
+func albumKey(artistName, name string) string {
+	return strings.ToLower(artistName) + "\x00" + strings.ToLower(name)
+}
@@ -0,0 +1,30 @@
+package spotifyexport
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Dir: unpacked "Download your data" archive (with YourLibrary.json).
+// Stored as account auth.
+func NewAccount(ctx context.Context, alias string, dir string) (shared.Account, error) {
+	dir, err := filepath.Abs(strings.TrimSpace(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check.
+	if _, err := loadExport(dir); err != nil {
+		return nil, err
+	}
+
+	account, err := _repo.CreateAccount(alias, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, err
+}
@@ -0,0 +1,71 @@
+package spotifyexport
+
+import (
+	"context"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+func newPlaylist(account shared.Account, playlist *exportPlaylist) *Playlist {
+	return &Playlist{
+		Entity:   newEntity(playlist.id, playlist.name),
+		account:  account,
+		playlist: playlist,
+	}
+}
+
+// Read-only.
+type Playlist struct {
+	*Entity
+	account  shared.Account
+	playlist *exportPlaylist
+}
+
+// Playlists have no IDs in export, so they are not Spotify entities.
+func (e Playlist) RemoteName() shared.RemoteName {
+	return RemoteName
+}
+
+func (e Playlist) FromAccount() shared.Account {
+	return e.account
+}
+
+func (e Playlist) Description() *string {
+	return e.playlist.description
+}
+
+func (e Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
+	result := make([]shared.RemoteTrack, len(e.playlist.tracks))
+	for i := range e.playlist.tracks {
+		result[i] = e.playlist.tracks[i]
+	}
+	return result, nil
+}
+
+func (e Playlist) Rename(ctx context.Context, newName string) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) SetDescription(ctx context.Context, newDesc string) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
+func (e Playlist) IsVisible() (bool, error) {
+	return false, shared.ErrNotImplemented
+}
+
+func (e Playlist) SetIsVisible(ctx context.Context, val bool) error {
+	return shared.ErrNotImplemented
+}
@@ -0,0 +1,73 @@
+package spotifyexport
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+var (
+	_repo shared.RemoteRepository
+)
+
+const (
+	RemoteName shared.RemoteName = "SpotifyExport"
+)
+
+type Remote struct {
+}
+
+func (s *Remote) Boot(repo shared.RemoteRepository) error {
+	_repo = repo
+	return nil
+}
+
+func (s Remote) Name() shared.RemoteName {
+	return RemoteName
+}
+
+func (s Remote) Repository() shared.RemoteRepository {
+	return _repo
+}
+
+func (s Remote) AssignAccountActions(account shared.Account) (shared.AccountActions, error) {
+	return newAccountActions(account)
+}
+
+func (s Remote) Actions() (shared.RemoteActions, error) {
+	accounts, err := _repo.Accounts(context.Background())
+	if err != nil || len(accounts) == 0 {
+		return nil, err
+	}
+
+	var exps []*export
+	for i := range accounts {
+		exp, err := getExport(accounts[i].Auth())
+		if err != nil {
+			slog.Error("getExport: " + err.Error())
+			continue
+		}
+		exps = append(exps, exp)
+	}
+
+	if len(exps) == 0 {
+		return nil, shared.ErrNoRemoteActions
+	}
+
+	return newActions(exps), nil
+}
+
+// Same IDs as Spotify.
+func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
+	return shared.GetEntityURL("http://open.spotify.com", etype, id)
+}
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// SpotifyExport: no network, only memory.
+	return shared.RemoteLimits{
+		Parallelism: 4,
+		Rate:        1000,
+	}
+}
@@ -0,0 +1,43 @@
+package spotifyexport
+
+import (
+	"net/url"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Only name, artist and album in export.
+type Track struct {
+	*Entity
+	artists []*Artist
+	album   *Album
+}
+
+func (e Track) ISRC() *string {
+	return nil
+}
+
+func (e Track) Artists() []shared.RemoteArtist {
+	return convertArtists(e.artists)
+}
+
+func (e Track) Album() (shared.RemoteAlbum, error) {
+	if e.album == nil {
+		return nil, nil
+	}
+	return e.album, nil
+}
+
+// Unknown.
+func (e Track) LengthMs() int {
+	return 0
+}
+
+// Unknown.
+func (e Track) Year() int {
+	return 0
+}
+
+func (e Track) CoverURL() *url.URL {
+	return nil
+}
@@ -0,0 +1,32 @@
+package spotifyexport
+
+import (
+	"github.com/oklookat/synchro/remote/spotify"
+	"github.com/oklookat/synchro/shared"
+)
+
+func newEntity(id, name string) *Entity {
+	return &Entity{
+		id:   id,
+		name: name,
+	}
+}
+
+// Tracks, albums, artists: IDs from Spotify URIs,
+// so they linked as Spotify entities.
+type Entity struct {
+	id   string
+	name string
+}
+
+func (e Entity) RemoteName() shared.RemoteName {
+	return spotify.RemoteName
+}
+
+func (e Entity) ID() shared.RemoteID {
+	return shared.RemoteID(e.id)
+}
+
+func (e Entity) Name() string {
+	return e.name
+}
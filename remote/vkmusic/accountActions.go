@@ -2,6 +2,7 @@ package vkmusic
 
 import (
 	"context"
+	"time"
 
 	"github.com/oklookat/govkm"
 	"github.com/oklookat/govkm/schema"
@@ -11,7 +12,7 @@ import (
 func newAccountActions(account shared.Account) (*AccountActions, error) {
 	client, err := getClient(account)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return &AccountActions{
 		account: account,
@@ -56,7 +57,7 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for {
 		data, err := e.client.LikedAlbums(ctx, limit, offset)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		if len(data.Data.Albums) == 0 {
 			break
@@ -73,6 +74,10 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return result, nil
 }
 
+func (e LikedAlbumsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
 func (e LikedAlbumsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -85,12 +90,12 @@ func (e LikedAlbumsActions) likeUnlike(ctx context.Context, ids []shared.RemoteI
 	for _, id := range ids {
 		if like {
 			if _, err := e.client.LikeAlbum(ctx, schema.ID(id)); err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		if _, err := e.client.UnlikeAlbum(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -109,7 +114,7 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 	for {
 		data, err := e.client.LikedArtists(ctx, limit, offset)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		if len(data.Data.Artists) == 0 {
 			break
@@ -126,6 +131,10 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 	return result, nil
 }
 
+func (e LikedArtistsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
 func (e LikedArtistsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -138,12 +147,12 @@ func (e LikedArtistsActions) likeUnlike(ctx context.Context, ids []shared.Remote
 	for _, id := range ids {
 		if like {
 			if _, err := e.client.LikeArtist(ctx, schema.ID(id)); err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		if _, err := e.client.UnlikeArtist(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -156,7 +165,7 @@ type LikedTracksActions struct {
 func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	likesPl, err := e.client.LikedTracks(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	var tracks []schema.Track
@@ -167,7 +176,7 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for {
 		data, err := e.client.PlaylistTracks(ctx, likesPl.APIID, limit, offset)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		if len(data.Data.Tracks) == 0 {
 			break
@@ -185,7 +194,7 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for i := range tracks {
 		track, err := newTrack(tracks[i], e.client)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		result = append(result, track)
 	}
@@ -193,6 +202,10 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	return result, nil
 }
 
+func (e LikedTracksActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
+}
+
 func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
 	return e.likeUnlike(ctx, ids, true)
 }
@@ -205,12 +218,12 @@ func (e LikedTracksActions) likeUnlike(ctx context.Context, ids []shared.RemoteI
 	for _, id := range ids {
 		if like {
 			if _, err := e.client.LikeTrack(ctx, schema.ID(id)); err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		if _, err := e.client.UnlikeTrack(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -230,7 +243,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 	for {
 		data, err := e.client.UserPlaylists(ctx, limit, offset)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 
 		if len(data.Data.Playlists) == 0 {
@@ -259,7 +272,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
 	resp, err := e.client.CreatePlaylist(ctx, name)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if resp.Data.Playlist == nil {
 		return nil, errNilPlaylist
@@ -270,7 +283,7 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 func (e PlaylistActions) Delete(ctx context.Context, entities []shared.RemoteID) error {
 	for _, id := range entities {
 		if _, err := e.client.DeletePlaylist(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -282,7 +295,7 @@ func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shar
 		if isNotFound(err) {
 			return nil, nil
 		}
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if resp.Data.Playlist == nil {
 		return nil, errNilPlaylist
@@ -24,7 +24,7 @@ type Actions struct {
 func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAlbum, error) {
 	resp, err := e.client.Album(ctx, schema.ID(id))
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if resp.Data.Album == nil {
 		return nil, nil
@@ -35,7 +35,7 @@ func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAl
 func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteArtist, error) {
 	resp, err := e.client.Artist(ctx, schema.ID(id))
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if resp.Data.Artist == nil {
 		return nil, nil
@@ -46,7 +46,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTrack, error) {
 	resp, err := e.client.Track(ctx, schema.ID(id))
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if resp.Data.Track == nil {
 		return nil, nil
@@ -82,7 +82,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 
 	resp, err := e.client.SearchAlbum(ctx, query, 10, 0)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -92,7 +92,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 		result[i] = newAlbum(&resp.Data.Albums[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type ArtistsSearchAction struct {
@@ -106,7 +106,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 
 	resp, err := e.client.SearchArtist(ctx, query, 11, 0)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -116,7 +116,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		result[i] = newArtist(resp.Data.Artists[i].SimpleArtist, e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type TracksSearchAction struct {
@@ -132,7 +132,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 
 	resp, err := e.client.SearchTrack(ctx, query, 11, 0)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range resp.Data.Tracks {
@@ -144,10 +144,10 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		// }
 		track, err := newTrack(resp.Data.Tracks[i], e.client)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = track
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
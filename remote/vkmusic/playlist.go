@@ -46,7 +46,7 @@ func (e Playlist) Description() *string {
 
 func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	if err := e.cacheTracks(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(e.cachedTracks) == 0 {
 		return nil, nil
@@ -56,7 +56,7 @@ func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	for i := range e.cachedTracks {
 		track, err := newTrack(e.cachedTracks[i], e.client)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		result = append(result, track)
 	}
@@ -66,7 +66,7 @@ func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 
 func (e *Playlist) Rename(ctx context.Context, newName string) error {
 	_, err := e.client.RenamePlaylist(ctx, e.playlist.APIID, newName)
-	return err
+	return wrapErr(err)
 }
 
 func (e Playlist) SetDescription(ctx context.Context, newDesc string) error {
@@ -87,7 +87,7 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 	}
 
 	if err := e.cacheTracks(ctx); err != nil {
-		return err
+		return wrapErr(err)
 	}
 
 	var converted []schema.ID
@@ -104,7 +104,7 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 	for _, id := range converted {
 		pl, err := e.client.AddTrackToPlaylist(ctx, e.playlist.APIID, id)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		if pl.Data.Playlist != nil {
 			e.playlist = *pl.Data.Playlist
@@ -117,7 +117,7 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 
 func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) error {
 	if err := e.cacheTracks(ctx); err != nil {
-		return err
+		return wrapErr(err)
 	}
 
 	var trackIds []schema.ID
@@ -146,14 +146,39 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 		e.playlist.Name,
 		e.playlist.APIID, trackIds)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 	if resp.Data.Playlist != nil {
 		e.playlist = *resp.Data.Playlist
 	}
 
 	e.cachedTracks = nil
-	return err
+	return wrapErr(err)
+}
+
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Edit keeps tracks in given order.
+	trackIds := make([]schema.ID, 0, len(ids))
+	for _, id := range ids {
+		trackIds = append(trackIds, schema.ID(id))
+	}
+
+	resp, err := e.client.EditPlaylist(ctx,
+		e.playlist.Name,
+		e.playlist.APIID, trackIds)
+	if err != nil {
+		return wrapErr(err)
+	}
+	if resp.Data.Playlist != nil {
+		e.playlist = *resp.Data.Playlist
+	}
+
+	e.cachedTracks = nil
+	return nil
 }
 
 func (e *Playlist) cacheTracks(ctx context.Context) error {
@@ -167,7 +192,7 @@ func (e *Playlist) cacheTracks(ctx context.Context) error {
 	for {
 		data, err := e.client.PlaylistTracks(ctx, e.playlist.APIID, 30, offset)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 
 		if len(data.Data.Tracks) == 0 {
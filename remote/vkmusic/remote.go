@@ -63,3 +63,11 @@ func (s Remote) Actions() (shared.RemoteActions, error) {
 func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
 	return shared.GetEntityURL("http://share.boom.ru", etype, id)
 }
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// VK Music: client limited to 2 requests per second.
+	return shared.RemoteLimits{
+		Parallelism: 1,
+		Rate:        2,
+	}
+}
@@ -43,3 +43,23 @@ func isNotFound(err error) bool {
 func isUgcTrack(tr schema.Track) bool {
 	return tr.IsUnofficial()
 }
+
+// Convert error to shared.ErrRemote.
+func wrapErr(err error) error {
+	if err == nil || shared.ErrorKindOf(err) != shared.ErrorKindUnknown {
+		return err
+	}
+	kind := shared.ErrorKindUnknown
+	var respErr schema.ResponseError
+	var statusErr schema.ErrorWithStatusCode
+	if errors.As(err, &respErr) {
+		if respErr.IsUnauthorized() {
+			kind = shared.ErrorKindAuth
+		} else if respErr.IsNotFound() {
+			kind = shared.ErrorKindNotFound
+		}
+	} else if errors.As(err, &statusErr) {
+		kind = shared.ErrorKindFromStatus(statusErr.StatusCode)
+	}
+	return shared.NewErrRemote(_repo.Name(), kind, err)
+}
@@ -2,6 +2,7 @@ package yandexmusic
 
 import (
 	"context"
+	"time"
 
 	"github.com/oklookat/goym"
 	"github.com/oklookat/goym/schema"
@@ -11,7 +12,7 @@ import (
 func newAccountActions(account shared.Account) (*AccountActions, error) {
 	client, err := getClient(account)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return &AccountActions{
 		account: account,
@@ -44,21 +45,24 @@ func (e AccountActions) Playlist() shared.PlaylistActions {
 }
 
 type LikedAlbumsActions struct {
-	client *goym.Client
+	client  *goym.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	resp, err := e.client.LikedAlbums(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(resp.Result) == 0 {
 		return nil, nil
 	}
 
+	e.likedAt = shared.LikedDates{}
 	ids := make([]schema.ID, len(resp.Result))
 	for i := range ids {
 		ids[i] = resp.Result[i].ID
+		e.likedAt[shared.RemoteID(ids[i])] = resp.Result[i].Timestamp
 	}
 
 	result := []shared.RemoteEntity{}
@@ -68,18 +72,22 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for i := range idsChunked {
 		alb, err := e.client.Albums(ctx, idsChunked[i])
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		for x := range alb.Result {
 			albWrap, err := newAlbum(alb.Result[x], e.client)
 			if err != nil {
-				return nil, err
+				return nil, wrapErr(err)
 			}
 			result = append(result, albWrap)
 		}
 	}
 
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedAlbumsActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
 }
 
 func (e LikedAlbumsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -101,19 +109,23 @@ type LikedArtistsActions struct {
 func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	resp, err := e.client.LikedArtists(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	result := []shared.RemoteEntity{}
 	for i := range resp.Result {
 		art, err := newArtist(resp.Result[i], e.client)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		result = append(result, art)
 	}
 
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedArtistsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
 }
 
 func (e LikedArtistsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -129,22 +141,25 @@ func (e LikedArtistsActions) likeUnlike(ctx context.Context, ids []shared.Remote
 }
 
 type LikedTracksActions struct {
-	client *goym.Client
+	client  *goym.Client
+	likedAt shared.LikedDates
 }
 
-func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
+func (e *LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	resp, err := e.client.LikedTracks(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(resp.Result.Library.Tracks) == 0 {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	lib := resp.Result.Library.Tracks
+	e.likedAt = shared.LikedDates{}
 	ids := make([]schema.ID, len(lib))
 	for i := range ids {
 		ids[i] = lib[i].ID
+		e.likedAt[shared.RemoteID(ids[i])] = lib[i].Timestamp
 	}
 
 	result := []shared.RemoteEntity{}
@@ -154,7 +169,7 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for i := range idsChunked {
 		track, err := e.client.Tracks(ctx, idsChunked[i])
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		for x := range track.Result {
 			if isUgcTrack(track.Result[x]) {
@@ -162,13 +177,17 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 			}
 			trackWrap, err := newTrack(track.Result[x], e.client)
 			if err != nil {
-				return nil, err
+				return nil, wrapErr(err)
 			}
 			result = append(result, trackWrap)
 		}
 	}
 
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedTracksActions) LikedAt(id shared.RemoteID) *time.Time {
+	return e.likedAt.At(id)
 }
 
 func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -205,12 +224,12 @@ func likeUnlike(
 	for i := range idsChunked {
 		if like {
 			if _, err := liker(ctx, idsChunked[i]); err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		if _, err := unliker(ctx, idsChunked[i]); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -230,7 +249,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 
 	playlists, err := e.client.MyPlaylists(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	result := []shared.RemotePlaylist{}
@@ -249,7 +268,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 	}
 
 	e.myPlaylists = result
-	return result, err
+	return result, wrapErr(err)
 }
 
 func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
@@ -265,7 +284,7 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 
 	pl, err := e.client.CreatePlaylist(ctx, name, desc, vis)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	return newPlaylist(e.account, pl.Result, e.client), err
@@ -274,7 +293,7 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 func (e PlaylistActions) Delete(ctx context.Context, ids []shared.RemoteID) error {
 	for _, id := range ids {
 		if _, err := e.client.DeletePlaylist(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -284,7 +303,7 @@ func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shar
 	pl, err := e.client.MyPlaylist(ctx, schema.ID(id))
 	notFound, err := isNotFoundOrErr(err, pl.Result.Title)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if notFound {
 		return nil, nil
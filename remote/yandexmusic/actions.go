@@ -22,7 +22,7 @@ func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAl
 	resp, err := e.client.Album(ctx, schema.ID(id), false)
 	notFound, err := isNotFoundOrErr(err, resp.Result.Title)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if notFound {
 		return nil, nil
@@ -34,7 +34,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 	resp, err := e.client.ArtistInfo(ctx, schema.ID(id))
 	notFound, err := isNotFoundOrErr(err, resp.Result.Artist.Name)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if notFound {
 		return nil, nil
@@ -45,7 +45,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTrack, error) {
 	resp, err := e.client.Track(ctx, schema.ID(id))
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(resp.Result) == 0 {
 		return nil, nil
@@ -84,7 +84,7 @@ func (e AlbumsSearchAction) Search(
 	result, err := e.search(ctx, query, false)
 	if err != nil || !shared.IsNil(result[0]) {
 		// Error or not found.
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	query = shared.SearchableNormalized(artistName, albumName)
@@ -107,7 +107,7 @@ func (e AlbumsSearchAction) search(
 	if useSuggest {
 		sug, sugQuery, err = e.suggest(ctx, query)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		// If no suggests,
 		// try to change suggested query if exists.
@@ -118,7 +118,7 @@ func (e AlbumsSearchAction) search(
 
 	search, err := e.client.Search(ctx, query, 0, schema.SearchTypeAlbum, false)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 	if len(search.Result.Albums.Results) == 0 {
 		return result, nil
@@ -140,7 +140,7 @@ func (e AlbumsSearchAction) search(
 		full, err := e.client.Album(ctx, search.Result.Albums.Results[i].ID, true)
 		notFound, err := isNotFoundOrErr(err, full.Result.Title)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		if notFound {
 			continue
@@ -148,7 +148,7 @@ func (e AlbumsSearchAction) search(
 
 		alb, err := newAlbum(full.Result, e.client)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = alb
 		i++
@@ -160,7 +160,7 @@ func (e AlbumsSearchAction) search(
 			}
 			albDup, err := newAlbum(full.Result.Duplicates[x], e.client)
 			if err != nil {
-				return result, err
+				return result, wrapErr(err)
 			}
 			result[i] = albDup
 			i++
@@ -168,7 +168,7 @@ func (e AlbumsSearchAction) search(
 
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 // Suggested, suggested query, error.
@@ -180,13 +180,13 @@ func (e AlbumsSearchAction) suggest(ctx context.Context, query string) (*Album,
 
 	suggests, err := e.client.SearchSuggest(ctx, query)
 	if err != nil {
-		return nil, "", err
+		return nil, "", wrapErr(err)
 	}
 	if suggests.Result.Best.Album != nil {
 		// The search prompts have a fucked up structure, so you have to do tricks to get the suggested album ID.
 		alb, err := newAlbum(*suggests.Result.Best.Album, e.client)
 		if err != nil {
-			return sug, sugQuery, err
+			return sug, sugQuery, wrapErr(err)
 		}
 		sug = alb
 	}
@@ -195,7 +195,7 @@ func (e AlbumsSearchAction) suggest(ctx context.Context, query string) (*Album,
 		sugQuery = suggests.Result.Best.Text
 	}
 
-	return sug, sugQuery, err
+	return sug, sugQuery, wrapErr(err)
 }
 
 type ArtistsSearchAction struct {
@@ -209,7 +209,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 
 	search, err := e.client.Search(ctx, query, 0, schema.SearchTypeArtist, false)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -218,12 +218,12 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		}
 		artist, err := newArtist(search.Result.Artists.Results[i], e.client)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = artist
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type TracksSearchAction struct {
@@ -237,7 +237,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 
 	search, err := e.client.Search(ctx, query, 0, schema.SearchTypeTrack, false)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range search.Result.Tracks.Results {
@@ -249,10 +249,10 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		}
 		track, err := newTrack(search.Result.Tracks.Results[i], e.client)
 		if err != nil {
-			return result, err
+			return result, wrapErr(err)
 		}
 		result[i] = track
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
@@ -36,7 +36,7 @@ func (e Playlist) Description() *string {
 
 func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	if err := e.cacheTracks(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	if len(e.trackItems) == 0 {
@@ -48,7 +48,7 @@ func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	for _, item := range e.trackItems {
 		track, err := newTrack(item.Track, e.client)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		result = append(result, track)
 	}
@@ -59,19 +59,19 @@ func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 func (e *Playlist) Rename(ctx context.Context, newName string) error {
 	pl, err := e.client.RenamePlaylist(ctx, e.playlist.Kind, newName)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 	e.playlist = pl.Result
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) SetDescription(ctx context.Context, newDesc string) error {
 	pl, err := e.client.SetPlaylistDescription(ctx, e.playlist.Kind, newDesc)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 	e.playlist = pl.Result
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
@@ -91,12 +91,12 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 	for i := range idsChunked {
 		tracks, err := e.client.Tracks(ctx, idsChunked[i])
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		toAdd = append(toAdd, tracks.Result...)
 		pl, err := e.client.AddToPlaylist(ctx, e.playlist, toAdd)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		e.playlist = pl.Result
 	}
@@ -111,7 +111,7 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	}
 
 	if err := e.cacheTracks(ctx); err != nil {
-		return err
+		return wrapErr(err)
 	}
 
 	converted := make([]schema.ID, len(ids))
@@ -124,7 +124,7 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	for i := range idsChunked {
 		resp, err := e.client.DeleteTracksFromPlaylist(ctx, e.playlist, idsChunked[i])
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		e.playlist = resp.Result
 	}
@@ -133,6 +133,11 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	return nil
 }
 
+// Client can only append tracks.
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	return shared.ErrNotImplemented
+}
+
 func (e *Playlist) IsVisible() (bool, error) {
 	return e.playlist.Visibility == schema.VisibilityPublic, nil
 }
@@ -145,12 +150,12 @@ func (e *Playlist) SetIsVisible(ctx context.Context, val bool) error {
 
 	pl, err := e.client.SetPlaylistVisibility(ctx, e.playlist.Kind, vis)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 
 	e.playlist = pl.Result
 	e.trackItems = nil
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) cacheTracks(ctx context.Context) error {
@@ -160,11 +165,11 @@ func (e *Playlist) cacheTracks(ctx context.Context) error {
 
 	pl, err := e.client.MyPlaylist(ctx, e.playlist.Kind)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 
 	e.trackItems = []schema.TrackItem{}
 	e.trackItems = append(e.trackItems, pl.Result.Tracks...)
 
-	return err
+	return wrapErr(err)
 }
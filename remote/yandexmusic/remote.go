@@ -64,3 +64,11 @@ func (s Remote) Actions() (shared.RemoteActions, error) {
 func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
 	return shared.GetEntityURL("https://music.yandex.ru", etype, id)
 }
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// Yandex.Music: limit not published.
+	return shared.RemoteLimits{
+		Parallelism: 2,
+		Rate:        5,
+	}
+}
@@ -68,3 +68,23 @@ func isNotFoundOrErr(err error, strCheck string) (bool, error) {
 func isUgcTrack(tr schema.Track) bool {
 	return tr.TrackSource == schema.TrackSourceUgc || (tr.Filename != nil && len(*tr.Filename) > 0)
 }
+
+// Convert error to shared.ErrRemote.
+func wrapErr(err error) error {
+	if err == nil || shared.ErrorKindOf(err) != shared.ErrorKindUnknown {
+		return err
+	}
+	kind := shared.ErrorKindUnknown
+	var respErr schema.Error
+	var statusErr schema.ErrWithStatusCode
+	if errors.As(err, &respErr) {
+		if respErr.IsSessionExpired() {
+			kind = shared.ErrorKindAuth
+		} else if respErr.IsNotFound() {
+			kind = shared.ErrorKindNotFound
+		}
+	} else if errors.As(err, &statusErr) {
+		kind = shared.ErrorKindFromStatus(statusErr.StatusCode)
+	}
+	return shared.NewErrRemote(_repo.Name(), kind, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/oklookat/gozvuk"
 	"github.com/oklookat/gozvuk/schema"
@@ -13,7 +14,7 @@ import (
 func newAccountActions(account shared.Account) (*AccountActions, error) {
 	client, err := getClient(account)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	return &AccountActions{
 		account: account,
@@ -52,7 +53,7 @@ type LikedAlbumsActions struct {
 func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	col, err := e.client.UserCollection(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	releases := col.Data.Collection.Releases
@@ -70,7 +71,7 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for i := range idsChunked {
 		albumd, err := e.client.GetReleases(ctx, idsChunked[i], 1)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		for x := range albumd.Data.GetReleases {
 			id := albumd.Data.GetReleases[x].ID
@@ -81,7 +82,11 @@ func (e LikedAlbumsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 		}
 	}
 
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedAlbumsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
 }
 
 func (e LikedAlbumsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -103,7 +108,7 @@ type LikedArtistsActions struct {
 func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	collResp, err := e.client.UserCollection(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	var ids []schema.ID
@@ -119,7 +124,7 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 	for _, chunk := range idsChunks {
 		artResp, err := e.client.GetArtists(ctx, chunk, false, 1, 0, false, 1, 0, false, 1, false)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		artists = append(artists, artResp.Data.GetArtists...)
 	}
@@ -131,7 +136,11 @@ func (e LikedArtistsActions) Liked(ctx context.Context) ([]shared.RemoteEntity,
 		}
 		result = append(result, newArtist(&artists[i].SimpleArtist, e.client))
 	}
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedArtistsActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
 }
 
 func (e LikedArtistsActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -153,11 +162,11 @@ type LikedTracksActions struct {
 func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, error) {
 	resp, err := e.client.UserTracks(ctx, schema.OrderByDateAdded, schema.OrderDirectionAsc)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(resp.Data.Collection.Tracks) == 0 ||
 		len(resp.Data.Collection.Tracks[0].ID) == 0 {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	var trackIds []schema.ID
@@ -172,7 +181,7 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 	for i := range idsChunked {
 		trackd, err := e.client.GetFullTrack(ctx, idsChunked[i])
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		for x := range trackd.Data.GetTracks {
 			if len(trackd.Data.GetTracks[x].ID) == 0 {
@@ -183,7 +192,11 @@ func (e LikedTracksActions) Liked(ctx context.Context) ([]shared.RemoteEntity, e
 		}
 	}
 
-	return result, err
+	return result, wrapErr(err)
+}
+
+func (e LikedTracksActions) LikedAt(shared.RemoteID) *time.Time {
+	return nil
 }
 
 func (e LikedTracksActions) Like(ctx context.Context, ids []shared.RemoteID) error {
@@ -211,13 +224,13 @@ func likeUnlike(ctx context.Context, cl *gozvuk.Client, ids []shared.RemoteID, i
 		if like {
 			_, err := cl.AddItemToCollection(ctx, id, itype)
 			if err != nil {
-				return err
+				return wrapErr(err)
 			}
 			continue
 		}
 		_, err := cl.RemoveItemFromCollection(ctx, id, itype)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -237,12 +250,12 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 	}
 	resp, err := e.client.UserPlaylists(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	prof, err := e.client.Profile()
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if prof.Result.ID == nil {
 		return nil, errors.New("nil profile id")
@@ -262,7 +275,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 	for _, chunk := range chunksIds {
 		playlists, err := e.client.GetPlaylists(ctx, chunk)
 		if err != nil {
-			return nil, err
+			return nil, wrapErr(err)
 		}
 		playlistsSlice := playlists.Data.GetPlaylists
 		for i := range playlistsSlice {
@@ -275,7 +288,7 @@ func (e *PlaylistActions) MyPlaylists(ctx context.Context) ([]shared.RemotePlayl
 	}
 
 	e.myPlaylists = result
-	return result, err
+	return result, wrapErr(err)
 }
 
 func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool, description *string) (shared.RemotePlaylist, error) {
@@ -284,18 +297,18 @@ func (e PlaylistActions) Create(ctx context.Context, name string, isVisible bool
 		schema.NewPlaylistItem(schema.PlaylistItemTypeTrack, _tempPlaylistTrackId),
 	}, name)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	id := resp.Data.Playlist.Create
 
 	_, err = e.client.SetPlaylistToPublic(ctx, id, isVisible)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	plResp, err := e.client.GetPlaylists(ctx, []schema.ID{id})
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	return newPlaylist(e.account, plResp.Data.GetPlaylists[0], e.client), err
@@ -307,7 +320,7 @@ func (e PlaylistActions) Delete(ctx context.Context, ids []shared.RemoteID) erro
 			continue
 		}
 		if _, err := e.client.DeletePlaylist(ctx, schema.ID(id)); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 	return nil
@@ -316,7 +329,7 @@ func (e PlaylistActions) Delete(ctx context.Context, ids []shared.RemoteID) erro
 func (e PlaylistActions) Playlist(ctx context.Context, id shared.RemoteID) (shared.RemotePlaylist, error) {
 	pl, err := e.client.GetPlaylists(ctx, []schema.ID{schema.ID(id)})
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(pl.Data.GetPlaylists) == 0 || len(pl.Data.GetPlaylists[0].ID) == 0 {
 		// Not found.
@@ -24,7 +24,7 @@ func (e Actions) Album(ctx context.Context, id shared.RemoteID) (shared.RemoteAl
 	}
 	album, err := e.client.GetReleases(ctx, []schema.ID{schema.ID(id)}, 1)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(album.Data.GetReleases) == 0 || len(album.Data.GetReleases[0].ID) == 0 {
 		return nil, nil
@@ -38,7 +38,7 @@ func (e Actions) Artist(ctx context.Context, id shared.RemoteID) (shared.RemoteA
 	}
 	resp, err := e.client.GetArtists(ctx, []schema.ID{schema.ID(id)}, false, 1, 0, false, 1, 0, false, 1, false)
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 	if len(resp.Data.GetArtists) == 0 || len(resp.Data.GetArtists[0].ID) == 0 {
 		return nil, nil
@@ -52,7 +52,7 @@ func (e Actions) Track(ctx context.Context, id shared.RemoteID) (shared.RemoteTr
 	}
 	trackd, err := e.client.GetFullTrack(ctx, []schema.ID{schema.ID(id)})
 	if err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	if len(trackd.Data.GetTracks) == 0 || len(trackd.Data.GetTracks[0].ID) == 0 {
@@ -89,7 +89,7 @@ func (e AlbumsSearchAction) Search(ctx context.Context, what shared.RemoteAlbum)
 	result, err := e.search(ctx, query)
 	if err != nil || shared.IsNil(result[0]) {
 		// Error or not found.
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	// Try search again (normalize + suggest).
@@ -109,7 +109,7 @@ func (e AlbumsSearchAction) search(ctx context.Context, query string) ([10]share
 	})
 
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	releases := search.Data.Search.Releases
@@ -127,7 +127,7 @@ func (e AlbumsSearchAction) search(ctx context.Context, query string) ([10]share
 
 	fullRel, err := e.client.GetReleases(ctx, releasesIds, 1)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -137,7 +137,7 @@ func (e AlbumsSearchAction) search(ctx context.Context, query string) ([10]share
 		result[i] = newAlbum(fullRel.Data.GetReleases[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type ArtistsSearchAction struct {
@@ -153,7 +153,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		Limit:   10,
 	})
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	artists := search.Data.Search.Artists
@@ -169,7 +169,7 @@ func (e ArtistsSearchAction) Search(ctx context.Context, what shared.RemoteArtis
 		result[i] = newArtist(&artists.Items[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
 
 type TracksSearchAction struct {
@@ -185,7 +185,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		Limit:  10,
 	})
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	tracks := search.Data.Search.Tracks
@@ -201,7 +201,7 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 
 	fullTracks, err := e.client.GetFullTrack(ctx, tracksIds)
 	if err != nil {
-		return result, err
+		return result, wrapErr(err)
 	}
 
 	for i := range result {
@@ -211,5 +211,5 @@ func (e TracksSearchAction) Search(ctx context.Context, what shared.RemoteTrack)
 		result[i] = newTrack(fullTracks.Data.GetTracks[i], e.client)
 	}
 
-	return result, err
+	return result, wrapErr(err)
 }
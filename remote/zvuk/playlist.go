@@ -42,7 +42,7 @@ func (e Playlist) Description() *string {
 
 func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 	if err := e.cacheTracks(ctx); err != nil {
-		return nil, err
+		return nil, wrapErr(err)
 	}
 
 	result := []shared.RemoteTrack{}
@@ -65,10 +65,10 @@ func (e *Playlist) Tracks(ctx context.Context) ([]shared.RemoteTrack, error) {
 func (e *Playlist) Rename(ctx context.Context, newName string) error {
 	_, err := e.client.RenamePlaylist(ctx, e.playlist.ID, newName)
 	if err != nil {
-		return err
+		return wrapErr(err)
 	}
 	e.playlist.Title = newName
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) SetDescription(ctx context.Context, newDesc string) error {
@@ -106,7 +106,7 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 		}
 		_, err := e.client.AddTracksToPlaylist(ctx, e.playlist.ID, items)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		for _, addedId := range idsChunked[i] {
 			e.playlist.Tracks = append(e.playlist.Tracks, struct {
@@ -120,7 +120,7 @@ func (e *Playlist) AddTracks(ctx context.Context, ids []shared.RemoteID) error {
 	// Remove temp track.
 	if ids[0] != shared.RemoteID(_tempPlaylistTrackId) && len(e.playlist.Tracks) > 1 {
 		if err := e.RemoveTracks(ctx, []shared.RemoteID{shared.RemoteID(_tempPlaylistTrackId)}); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -137,7 +137,7 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 		// Keep one track, because we can't have
 		// playlist without tracks.
 		if err := e.AddTracks(ctx, []shared.RemoteID{shared.RemoteID(_tempPlaylistTrackId)}); err != nil {
-			return err
+			return wrapErr(err)
 		}
 	}
 
@@ -190,7 +190,36 @@ func (e *Playlist) RemoveTracks(ctx context.Context, ids []shared.RemoteID) erro
 	}
 
 	e.cachedTracks = nil
-	return err
+	return wrapErr(err)
+}
+
+func (e *Playlist) Reorder(ctx context.Context, ids []shared.RemoteID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Update replaces all tracks, in given order.
+	items := make([]schema.PlaylistItem, 0, len(ids))
+	var tracks []struct {
+		ID schema.ID "json:\"id\""
+	}
+	for _, id := range ids {
+		items = append(items, schema.PlaylistItem{
+			Type:   schema.PlaylistItemTypeTrack,
+			ItemID: schema.ID(id),
+		})
+		tracks = append(tracks, struct {
+			ID schema.ID "json:\"id\""
+		}{schema.ID(id)})
+	}
+
+	_, err := e.client.UpdataPlaylist(ctx, e.playlist.ID, items, e.playlist.IsPublic, e.playlist.Title)
+	if err == nil {
+		e.playlist.Tracks = tracks
+	}
+
+	e.cachedTracks = nil
+	return wrapErr(err)
 }
 
 func (e *Playlist) IsVisible() (bool, error) {
@@ -199,7 +228,7 @@ func (e *Playlist) IsVisible() (bool, error) {
 
 func (e *Playlist) SetIsVisible(ctx context.Context, val bool) error {
 	_, err := e.client.SetPlaylistToPublic(ctx, e.playlist.ID, val)
-	return err
+	return wrapErr(err)
 }
 
 func (e *Playlist) cacheTracks(ctx context.Context) error {
@@ -223,7 +252,7 @@ func (e *Playlist) cacheTracks(ctx context.Context) error {
 
 		resp, err := e.client.GetFullTrack(ctx, ids)
 		if err != nil {
-			return err
+			return wrapErr(err)
 		}
 		e.cachedTracks = append(e.cachedTracks, resp.Data.GetTracks...)
 	}
@@ -63,3 +63,11 @@ func (s Remote) Actions() (shared.RemoteActions, error) {
 func (e Remote) EntityURL(etype shared.EntityType, id shared.RemoteID) url.URL {
 	return shared.GetEntityURL("https://zvuk.com", etype, id)
 }
+
+func (e Remote) Limits() shared.RemoteLimits {
+	// Zvuk: client limited to 10 requests per second.
+	return shared.RemoteLimits{
+		Parallelism: 4,
+		Rate:        10,
+	}
+}
@@ -1,8 +1,10 @@
 package zvuk
 
 import (
+	"errors"
 	"time"
 
+	"github.com/oklookat/gozvuk/schema"
 	"github.com/oklookat/synchro/shared"
 	"golang.org/x/oauth2"
 )
@@ -39,3 +41,19 @@ func (e Entity) ID() shared.RemoteID {
 func (e Entity) Name() string {
 	return e.name
 }
+
+// Convert error to shared.ErrRemote.
+func wrapErr(err error) error {
+	if err == nil || shared.ErrorKindOf(err) != shared.ErrorKindUnknown {
+		return err
+	}
+	kind := shared.ErrorKindUnknown
+	var respErr schema.ResponseError
+	var respErrs schema.ResponseErrors
+	if errors.As(err, &respErr) {
+		kind = shared.ErrorKindFromStatus(respErr.StatusCode)
+	} else if errors.As(err, &respErrs) && len(respErrs) > 0 {
+		kind = shared.ErrorKindFromStatus(respErrs[0].StatusCode)
+	}
+	return shared.NewErrRemote(_repo.Name(), kind, err)
+}
@@ -9,15 +9,73 @@ import (
 
 func AccountByID(id shared.RepositoryID) (shared.Account, error) {
 	const query = "SELECT * FROM account WHERE id = ? LIMIT 1"
-	return dbGetOne[Account](context.Background(), query, id)
+	return accountGetOne(context.Background(), query, id)
+}
+
+// Re-encrypt auth of all accounts with new passphrase.
+//
+// Empty passphrase - store auth as plain text.
+//
+// Returns accounts count.
+func Rekey(ctx context.Context, passphrase string) (int, error) {
+	accounts, err := dbGetMany[Account](ctx, "SELECT * FROM account", (*Account).decrypt)
+	if err != nil {
+		return 0, err
+	}
+
+	next := &secret{}
+	if err := next.set(passphrase); err != nil {
+		return 0, err
+	}
+	err = Transaction(ctx, func(ctx context.Context) error {
+		for _, acc := range accounts {
+			encrypted, err := next.encrypt(acc.auth, acc.HID.String())
+			if err != nil {
+				return err
+			}
+			if _, err := dbExec(ctx, "UPDATE account SET auth=? WHERE id=?", encrypted, acc.HID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_secret.mu.Lock()
+	defer _secret.mu.Unlock()
+	return len(accounts), _secret.set(passphrase)
+}
+
+// Get one account with decrypted auth.
+func accountGetOne(ctx context.Context, query string, args ...interface{}) (*Account, error) {
+	acc, err := dbGetOne[Account](ctx, query, args...)
+	if err != nil || acc == nil {
+		return acc, err
+	}
+	return acc, acc.decrypt()
 }
 
 type Account struct {
 	HID         shared.RepositoryID `db:"id"`
 	HRemoteName shared.RemoteName   `db:"remote_name"`
-	HAuth       string              `db:"auth"`
 	HAlias      string              `db:"alias"`
 	HAddedAt    int64               `db:"added_at"`
+
+	// Encrypted, if key set.
+	HAuth string `db:"auth"`
+
+	// Decrypted.
+	auth string `db:"-"`
+}
+
+func (e *Account) decrypt() error {
+	auth, err := _secret.decrypt(e.HAuth, e.HID.String())
+	if err == nil {
+		e.auth = auth
+	}
+	return err
 }
 
 func (e Account) ID() shared.RepositoryID {
@@ -42,14 +100,19 @@ func (e *Account) SetAlias(alias string) error {
 }
 
 func (e Account) Auth() string {
-	return e.HAuth
+	return e.auth
 }
 
 func (e *Account) SetAuth(auth string) error {
+	encrypted, err := encryptAuth(context.Background(), auth, e.HID.String())
+	if err != nil {
+		return err
+	}
 	const query = "UPDATE account SET auth=? WHERE id=?"
-	_, err := dbExec(context.Background(), query, auth, e.HID)
+	_, err = dbExec(context.Background(), query, encrypted, e.HID)
 	if err == nil {
-		e.HAuth = auth
+		e.HAuth = encrypted
+		e.auth = auth
 	}
 	return err
 }
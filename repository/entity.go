@@ -24,16 +24,20 @@ func NewEntityRepository(name EntityName) EntityRepository {
 	}
 }
 
-func (e EntityRepository) CreateEntity() (shared.EntityID, error) {
+func (e EntityRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return Transaction(ctx, fn)
+}
+
+func (e EntityRepository) CreateEntity(ctx context.Context) (shared.EntityID, error) {
 	query := fmt.Sprintf(`INSERT INTO %s (id) VALUES (?) RETURNING *`, e.name)
-	ent, err := dbGetOne[Entity](context.Background(), query, genEntityID())
+	ent, err := dbGetOne[Entity](ctx, query, genEntityID())
 	if err != nil {
 		return "", err
 	}
 	return shared.EntityID(ent.ID()), err
 }
 
-func (e EntityRepository) DeleteNotLinked() error {
+func (e EntityRepository) DeleteNotLinked(ctx context.Context) error {
 	query := fmt.Sprintf(`DELETE FROM %s
 	WHERE NOT EXISTS (
 		SELECT 1 FROM linked_%s
@@ -43,13 +47,13 @@ func (e EntityRepository) DeleteNotLinked() error {
 		SELECT 1 FROM linked_%s
 		WHERE linked_%s.entity_id = %s.id AND linked_%s.id_on_remote IS NULL
 	);`, e.name, e.name, e.name, e.name, e.name, e.name, e.name, e.name, e.name)
-	_, err := dbExec(context.Background(), query)
+	_, err := dbExec(ctx, query)
 	return err
 }
 
-func (e EntityRepository) DeleteAll() error {
+func (e EntityRepository) DeleteAll(ctx context.Context) error {
 	query := "DELETE FROM " + e.name
-	_, err := dbExec(context.Background(), query.String())
+	_, err := dbExec(ctx, query.String())
 	return err
 }
 
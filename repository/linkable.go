@@ -22,20 +22,25 @@ func NewLinkableEntity(entityName EntityName, remoteName shared.RemoteName) *Lin
 	}
 }
 
-func (e LinkableEntity) CreateLink(ctx context.Context, eId shared.EntityID, id *shared.RemoteID) (linker.Linked, error) {
-	query := fmt.Sprintf(`INSERT INTO linked_%s (id, entity_id, remote_name, id_on_remote, modified_at)
-	VALUES (?, ?, ?, ?, ?) RETURNING *;`, e.entityName)
-	return e.getOne(ctx, query, genRepositoryID(), eId, e.remoteName, id, shared.TimestampNow())
+func (e LinkableEntity) CreateLink(ctx context.Context, eId shared.EntityID, id *shared.RemoteID, match linker.MatchResult) (linker.Linked, error) {
+	query := fmt.Sprintf(`INSERT INTO linked_%s (id, entity_id, remote_name, id_on_remote, modified_at, match_score, match_method, matcher_version)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING *;`, e.entityName)
+	return e.getOne(ctx, query, genRepositoryID(), eId, e.remoteName, id, shared.TimestampNow(),
+		match.Score, matchMethodToDB(match.Method), match.Version)
 }
 
-func (e LinkableEntity) LinkedEntity(eId shared.EntityID) (linker.Linked, error) {
-	query := fmt.Sprintf("SELECT * FROM linked_%s WHERE entity_id=? AND remote_name=? LIMIT 1", e.entityName)
-	return e.getOne(context.Background(), query, eId, e.remoteName)
+func (e LinkableEntity) LinkedEntity(ctx context.Context, eId shared.EntityID) (linker.Linked, error) {
+	return e.linkedEntity(ctx, eId)
 }
 
-func (e LinkableEntity) LinkedRemoteID(id shared.RemoteID) (linker.Linked, error) {
+func (e LinkableEntity) LinkedRemoteID(ctx context.Context, id shared.RemoteID) (linker.Linked, error) {
 	query := fmt.Sprintf("SELECT * FROM linked_%s WHERE id_on_remote=? AND remote_name=? LIMIT 1", e.entityName)
-	return e.getOne(context.Background(), query, id, e.remoteName)
+	return e.getOne(ctx, query, id, e.remoteName)
+}
+
+func (e LinkableEntity) linkedEntity(ctx context.Context, eId shared.EntityID) (*LinkedEntity, error) {
+	query := fmt.Sprintf("SELECT * FROM linked_%s WHERE entity_id=? AND remote_name=? LIMIT 1", e.entityName)
+	return e.getOne(ctx, query, eId, e.remoteName)
 }
 
 func (e LinkableEntity) getOne(ctx context.Context, query string, args ...interface{}) (*LinkedEntity, error) {
@@ -64,6 +69,11 @@ type LinkedEntity struct {
 	IdOnRemote  *shared.RemoteID    `db:"id_on_remote"`
 	HModifiedAt int64               `db:"modified_at"`
 
+	HMatchScore     float64             `db:"match_score"`
+	HMatchMethod    *linker.MatchMethod `db:"match_method"`
+	HMatcherVersion int                 `db:"matcher_version"`
+	HPinned         bool                `db:"pinned"`
+
 	entityName EntityName `json:"-" db:"-"`
 }
 
@@ -75,18 +85,47 @@ func (e LinkedEntity) RemoteID() *shared.RemoteID {
 	return e.IdOnRemote
 }
 
-func (e *LinkedEntity) SetRemoteID(id *shared.RemoteID) error {
+func (e *LinkedEntity) SetRemoteID(ctx context.Context, id *shared.RemoteID, match linker.MatchResult) error {
 	var copied *shared.RemoteID
 	if id != nil {
 		cp := *id
 		copied = &cp
 	}
 	now := shared.TimestampNow()
-	query := fmt.Sprintf("UPDATE linked_%s SET id_on_remote=?,modified_at=? WHERE id=?", e.entityName)
-	_, err := dbExec(context.Background(), query, copied, now, e.HID)
+	method := matchMethodToDB(match.Method)
+	query := fmt.Sprintf(`UPDATE linked_%s SET id_on_remote=?,modified_at=?,
+	match_score=?,match_method=?,matcher_version=? WHERE id=?`, e.entityName)
+	_, err := dbExec(ctx, query, copied, now, match.Score, method, match.Version, e.HID)
 	if err == nil {
 		e.IdOnRemote = id
 		e.HModifiedAt = now
+		e.HMatchScore = match.Score
+		e.HMatchMethod = method
+		e.HMatcherVersion = match.Version
+	}
+	return err
+}
+
+func (e LinkedEntity) Match() linker.MatchResult {
+	result := linker.MatchResult{
+		Score:   e.HMatchScore,
+		Version: e.HMatcherVersion,
+	}
+	if e.HMatchMethod != nil {
+		result.Method = *e.HMatchMethod
+	}
+	return result
+}
+
+func (e LinkedEntity) Pinned() bool {
+	return e.HPinned
+}
+
+func (e *LinkedEntity) SetPinned(ctx context.Context, pinned bool) error {
+	query := fmt.Sprintf("UPDATE linked_%s SET pinned=? WHERE id=?", e.entityName)
+	_, err := dbExec(ctx, query, pinned, e.HID)
+	if err == nil {
+		e.HPinned = pinned
 	}
 	return err
 }
@@ -104,5 +143,14 @@ func DeleteLinks() error {
 			return err
 		}
 	}
-	return nil
+	_, err := dbExec(ctx, "DELETE FROM review")
+	return err
+}
+
+// Empty method to NULL.
+func matchMethodToDB(method linker.MatchMethod) *linker.MatchMethod {
+	if len(method) == 0 {
+		return nil
+	}
+	return &method
 }
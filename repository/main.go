@@ -2,9 +2,7 @@ package repository
 
 import (
 	"context"
-	_ "embed"
 	"os"
-	"strconv"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
@@ -31,14 +29,14 @@ var (
 	TrackLinkable  = NewEntityRepository(EntityNameTrack)
 )
 
-func NewLinkablePlaylist(accountID uint64) *LinkableEntity {
-	return NewLinkableEntity("playlist", shared.RemoteName(strconv.FormatUint(accountID, 10)))
+// Playlists are linked with accounts, not remotes.
+func NewLinkablePlaylist(accountID shared.RepositoryID) *LinkableEntity {
+	return NewLinkableEntity(EntityNamePlaylist, shared.RemoteName(accountID))
 }
 
 var (
-	//go:embed library.sql
-	_librarySQL string
-	_db         *sqlx.DB
+	_db     *sqlx.DB
+	_dbPath string
 )
 
 func Boot(dbPath string, remotes map[shared.RemoteName]shared.Remote) error {
@@ -50,8 +48,15 @@ func Boot(dbPath string, remotes map[shared.RemoteName]shared.Remote) error {
 	if _db, err = sqlx.Open("sqlite3", dbPath); err != nil {
 		return err
 	}
+	// SQLite allows one writer. Linker can be used concurrently,
+	// so share one connection to avoid "database is locked".
+	_db.SetMaxOpenConns(1)
 
-	if _, err = dbExec(context.Background(), _librarySQL); err != nil {
+	_dbPath = dbPath
+	if _, err = dbExec(context.Background(), "PRAGMA foreign_keys = ON"); err != nil {
+		return err
+	}
+	if _, err = Migrate(context.Background()); err != nil {
 		return err
 	}
 
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Boot empty temp database with remotes.
+func bootTest(t *testing.T, remotes ...shared.RemoteName) {
+	t.Helper()
+	if err := Boot(filepath.Join(t.TempDir(), "data.sqlite"), nil); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_db.Close()
+	})
+
+	const query = "INSERT INTO remote (id, name) VALUES (?, ?)"
+	for _, name := range remotes {
+		if _, err := dbExec(context.Background(), query, genRepositoryID(), name); err != nil {
+			t.Fatal(err)
+		}
+		// Only names used.
+		Remotes[name] = nil
+	}
+}
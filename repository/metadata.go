@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Cached metadata of remote entity.
+//
+// Returns nil if not cached, or cached before maxAge.
+func Metadata[T any](ctx context.Context, entityName EntityName, remoteName shared.RemoteName, id shared.RemoteID, maxAge time.Duration) (*T, error) {
+	query := fmt.Sprintf("SELECT data FROM %s_metadata WHERE remote_name=? AND id_on_remote=? AND cached_at>=? LIMIT 1", entityName)
+	minCachedAt := shared.Timestamp(time.Now().Add(-maxAge))
+	data, err := dbGetOneSimple[string](ctx, query, remoteName, id, minCachedAt)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	result := new(T)
+	if err := json.Unmarshal([]byte(*data), result); err != nil {
+		return nil, err
+	}
+	return result, err
+}
+
+// Save or refresh metadata of remote entity.
+//
+// Remote must exist in DB.
+func SetMetadata(ctx context.Context, entityName EntityName, remoteName shared.RemoteName, id shared.RemoteID, name string, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s_metadata (remote_name, id_on_remote, name, data, cached_at) VALUES (?, ?, ?, ?, ?)
+	ON CONFLICT (remote_name, id_on_remote) DO UPDATE SET name=excluded.name, data=excluded.data, cached_at=excluded.cached_at`, entityName)
+	_, err = dbExec(ctx, query, remoteName, id, name, string(encoded), shared.TimestampNow())
+	return err
+}
+
+// Delete metadata cached before maxAge.
+func DeleteExpiredMetadata(ctx context.Context, maxAge time.Duration) error {
+	minCachedAt := shared.Timestamp(time.Now().Add(-maxAge))
+	for _, name := range []EntityName{EntityNameArtist, EntityNameAlbum, EntityNameTrack} {
+		query := fmt.Sprintf("DELETE FROM %s_metadata WHERE cached_at<?", name)
+		if _, err := dbExec(ctx, query, minCachedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Numbered up-migrations. Example: 005_remote_is_enabled.sql.
+//
+// Never edit applied migrations, add new one instead.
+//
+//go:embed migrations/*.sql
+var _migrationsFS embed.FS
+
+const _schemaVersionSQL = `CREATE TABLE IF NOT EXISTS schema_version (
+    version INTEGER PRIMARY KEY,
+    name TEXT NOT NULL,
+    applied_at INTEGER NOT NULL
+);`
+
+type Migration struct {
+	Version int
+	Name    string
+
+	// Nil if pending.
+	AppliedAt *time.Time
+
+	query string
+}
+
+// All migrations, oldest first.
+func Migrations(ctx context.Context) ([]*Migration, error) {
+	if _, err := dbExec(ctx, _schemaVersionSQL); err != nil {
+		return nil, err
+	}
+
+	files, err := fs.Glob(_migrationsFS, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Migration, 0, len(files))
+	for _, file := range files {
+		mig, err := readMigration(file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, mig)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+
+	type applied struct {
+		Version   int   `db:"version"`
+		AppliedAt int64 `db:"applied_at"`
+	}
+	rows, err := dbGetMany[applied](ctx, "SELECT version, applied_at FROM schema_version", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for _, mig := range result {
+			if mig.Version == row.Version {
+				at := shared.Time(row.AppliedAt)
+				mig.AppliedAt = &at
+			}
+		}
+	}
+	return result, err
+}
+
+// Example: "migrations/005_remote_is_enabled.sql".
+func readMigration(file string) (*Migration, error) {
+	base := strings.TrimSuffix(path.Base(file), ".sql")
+	num, name, _ := strings.Cut(base, "_")
+	version, err := strconv.Atoi(num)
+	if err != nil || version < 1 {
+		return nil, fmt.Errorf("bad migration name: %s", file)
+	}
+	query, err := _migrationsFS.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return &Migration{Version: version, Name: name, query: string(query)}, err
+}
+
+// Apply pending migrations, each in transaction.
+//
+// Backup of DB file created before first pending migration, if DB not empty.
+//
+// Returns applied count.
+func Migrate(ctx context.Context) (int, error) {
+	migrations, err := Migrations(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pending := []*Migration{}
+	for _, mig := range migrations {
+		if mig.AppliedAt == nil {
+			pending = append(pending, mig)
+		}
+	}
+	if len(pending) == 0 {
+		return 0, err
+	}
+
+	if err := backupBeforeMigrate(ctx, pending[0].Version); err != nil {
+		return 0, err
+	}
+
+	for i, mig := range pending {
+		slog.Info("Migrating", "version", mig.Version, "name", mig.Name)
+		if err := applyMigration(ctx, mig); err != nil {
+			return i, fmt.Errorf("migration %d (%s): %w", mig.Version, mig.Name, err)
+		}
+	}
+	return len(pending), err
+}
+
+func applyMigration(ctx context.Context, mig *Migration) error {
+	return Transaction(ctx, func(ctx context.Context) error {
+		if _, err := dbExec(ctx, mig.query); err != nil {
+			return err
+		}
+		const query = "INSERT INTO schema_version (version, name, applied_at) VALUES (?, ?, ?)"
+		_, err := dbExec(ctx, query, mig.Version, mig.Name, shared.TimestampNow())
+		return err
+	})
+}
+
+// Example: data.sqlite.v2-1700000000.bak.
+func backupBeforeMigrate(ctx context.Context, version int) error {
+	tables, err := dbGetOneSimple[int](ctx, "SELECT count(*) FROM sqlite_master WHERE type='table' AND name!='schema_version'")
+	if err != nil {
+		return err
+	}
+	if *tables == 0 {
+		// Fresh DB.
+		return err
+	}
+	backupPath := fmt.Sprintf("%s.v%d-%d.bak", _dbPath, version, shared.TimestampNow())
+	if _, err := dbExec(ctx, "VACUUM INTO ?", backupPath); err != nil {
+		return err
+	}
+	slog.Info("DB backup created", "path", backupPath)
+	return err
+}
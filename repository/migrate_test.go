@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/oklookat/synchro/shared"
+)
+
+func TestMigrateBaseline(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "data.sqlite")
+
+	// DB created before migrations, with link.
+	baseline, err := _migrationsFS.ReadFile("migrations/001_initial.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sqlx.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(string(baseline) + `
+	INSERT INTO remote (id, name) VALUES ('1', 'Test');
+	INSERT INTO track (id) VALUES ('2');
+	INSERT INTO linked_track (id, entity_id, remote_name, id_on_remote, modified_at) VALUES ('3', '2', 'Test', 'remoteID', 1);`)
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Boot(dbPath, nil); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_db.Close()
+	})
+
+	migrations, err := Migrations(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, mig := range migrations {
+		if mig.Version != i+1 || mig.AppliedAt == nil {
+			t.Fatalf("migration %d (%s) not applied", mig.Version, mig.Name)
+		}
+	}
+
+	backups, err := filepath.Glob(dbPath + ".v1-*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("expected backup, got %v", backups)
+	}
+
+	linked, err := NewLinkableEntity(EntityNameTrack, "Test").LinkedEntity(ctx, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shared.IsNil(linked) || *linked.RemoteID() != "remoteID" || linked.Pinned() {
+		t.Fatalf("link not preserved: %+v", linked)
+	}
+
+	// Nothing pending.
+	if count, err := Migrate(ctx); err != nil || count != 0 {
+		t.Fatalf("expected no migrations, got %d (%v)", count, err)
+	}
+}
@@ -0,0 +1,16 @@
+-- How link was matched.
+ALTER TABLE linked_artist ADD COLUMN match_score REAL NOT NULL DEFAULT 0;
+ALTER TABLE linked_artist ADD COLUMN match_method TEXT DEFAULT NULL;
+ALTER TABLE linked_artist ADD COLUMN matcher_version INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE linked_album ADD COLUMN match_score REAL NOT NULL DEFAULT 0;
+ALTER TABLE linked_album ADD COLUMN match_method TEXT DEFAULT NULL;
+ALTER TABLE linked_album ADD COLUMN matcher_version INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE linked_track ADD COLUMN match_score REAL NOT NULL DEFAULT 0;
+ALTER TABLE linked_track ADD COLUMN match_method TEXT DEFAULT NULL;
+ALTER TABLE linked_track ADD COLUMN matcher_version INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE linked_playlist ADD COLUMN match_score REAL NOT NULL DEFAULT 0;
+ALTER TABLE linked_playlist ADD COLUMN match_method TEXT DEFAULT NULL;
+ALTER TABLE linked_playlist ADD COLUMN matcher_version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Link set by human. Linker never changes pinned links.
+ALTER TABLE linked_artist ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE linked_album ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE linked_track ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE linked_playlist ADD COLUMN pinned INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,99 @@
+------ PLAYLISTS SYNC
+-- Source playlist state at last sync.
+CREATE TABLE synced_playlist (
+    entity_id TEXT PRIMARY KEY REFERENCES playlist (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT DEFAULT NULL,
+    is_visible INTEGER DEFAULT NULL,
+    synced_at INTEGER NOT NULL
+);
+
+CREATE TABLE synced_playlist_track (
+    entity_id TEXT NOT NULL REFERENCES synced_playlist (entity_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    id_on_remote TEXT NOT NULL,
+    PRIMARY KEY (entity_id, position)
+);
+
+------ REVIEW
+CREATE TABLE review (
+    id TEXT PRIMARY KEY,
+    entity_name TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    source_remote_name TEXT NOT NULL REFERENCES remote (name) ON DELETE CASCADE,
+    source_id_on_remote TEXT NOT NULL,
+    source_name TEXT NOT NULL,
+    target_remote_name TEXT NOT NULL REFERENCES remote (name) ON DELETE CASCADE,
+    added_at INTEGER NOT NULL,
+    UNIQUE (entity_name, entity_id, target_remote_name)
+);
+
+CREATE TABLE review_candidate (
+    review_id TEXT NOT NULL REFERENCES review (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    id_on_remote TEXT NOT NULL,
+    name TEXT NOT NULL,
+    score REAL NOT NULL,
+    PRIMARY KEY (review_id, position)
+);
+
+------ TRANSFER
+CREATE TABLE transfer_job (
+    id TEXT PRIMARY KEY,
+    from_account_id TEXT NOT NULL REFERENCES account (id) ON DELETE CASCADE,
+    to_account_id TEXT NOT NULL REFERENCES account (id) ON DELETE CASCADE,
+    options TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    modified_at INTEGER NOT NULL
+);
+
+CREATE TABLE transfer_section (
+    id TEXT PRIMARY KEY,
+    job_id TEXT NOT NULL REFERENCES transfer_job (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    source_id_on_remote TEXT NOT NULL DEFAULT '',
+    target_id_on_remote TEXT DEFAULT NULL,
+    is_done INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (job_id, kind, source_id_on_remote)
+);
+
+CREATE TABLE transfer_item (
+    id TEXT PRIMARY KEY,
+    section_id TEXT NOT NULL REFERENCES transfer_section (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    source_id_on_remote TEXT NOT NULL,
+    name TEXT NOT NULL,
+    target_id_on_remote TEXT DEFAULT NULL,
+    status TEXT NOT NULL,
+    error TEXT DEFAULT NULL,
+    modified_at INTEGER NOT NULL
+);
+
+-- Changes made in target by transfer. For undo.
+CREATE TABLE transfer_change (
+    section_id TEXT NOT NULL REFERENCES transfer_section (id) ON DELETE CASCADE,
+    -- Example: "added" (liked, or added to playlist), "removed" (unliked, or removed from playlist).
+    action TEXT NOT NULL,
+    id_on_remote TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (section_id, action, id_on_remote)
+);
+
+------ SYNC
+-- Liked entities of account at last sync.
+CREATE TABLE snapshot (
+    id TEXT PRIMARY KEY,
+    -- Accounts synced together.
+    sync_key TEXT NOT NULL,
+    account_id TEXT NOT NULL REFERENCES account (id) ON DELETE CASCADE,
+    entity_name TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    UNIQUE (sync_key, account_id, entity_name)
+);
+
+CREATE TABLE snapshot_liked (
+    snapshot_id TEXT NOT NULL REFERENCES snapshot (id) ON DELETE CASCADE,
+    id_on_remote TEXT NOT NULL,
+    PRIMARY KEY (snapshot_id, id_on_remote)
+);
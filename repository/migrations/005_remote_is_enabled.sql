@@ -0,0 +1,2 @@
+-- Scanned by repository.Remote, but never created.
+ALTER TABLE remote ADD COLUMN is_enabled INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,29 @@
+------ METADATA
+-- Remote entities metadata, to avoid API requests.
+-- data: entity in archive format (JSON).
+CREATE TABLE artist_metadata (
+    remote_name TEXT NOT NULL REFERENCES remote (name) ON DELETE CASCADE,
+    id_on_remote TEXT NOT NULL,
+    name TEXT NOT NULL,
+    data TEXT NOT NULL,
+    cached_at INTEGER NOT NULL,
+    PRIMARY KEY (remote_name, id_on_remote)
+);
+
+CREATE TABLE album_metadata (
+    remote_name TEXT NOT NULL REFERENCES remote (name) ON DELETE CASCADE,
+    id_on_remote TEXT NOT NULL,
+    name TEXT NOT NULL,
+    data TEXT NOT NULL,
+    cached_at INTEGER NOT NULL,
+    PRIMARY KEY (remote_name, id_on_remote)
+);
+
+CREATE TABLE track_metadata (
+    remote_name TEXT NOT NULL REFERENCES remote (name) ON DELETE CASCADE,
+    id_on_remote TEXT NOT NULL,
+    name TEXT NOT NULL,
+    data TEXT NOT NULL,
+    cached_at INTEGER NOT NULL,
+    PRIMARY KEY (remote_name, id_on_remote)
+);
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Returns nil, nil if playlist not synced before.
+func SyncedPlaylistByEntity(entityID shared.EntityID) (*SyncedPlaylist, error) {
+	const query = "SELECT * FROM synced_playlist WHERE entity_id=? LIMIT 1"
+	return dbGetOne[SyncedPlaylist](context.Background(), query, entityID)
+}
+
+// Save source playlist state (replaces previous).
+//
+// IsVisible: nil if remote not supports visibility.
+func SaveSyncedPlaylist(ctx context.Context, entityID shared.EntityID, source shared.RemotePlaylist, isVisible *bool, trackIDs []shared.RemoteID) error {
+	return Transaction(ctx, func(ctx context.Context) error {
+		const deleteQuery = "DELETE FROM synced_playlist WHERE entity_id=?"
+		if _, err := dbExec(ctx, deleteQuery, entityID); err != nil {
+			return err
+		}
+
+		const query = `INSERT INTO synced_playlist (entity_id, name, description, is_visible, synced_at)
+		VALUES (?, ?, ?, ?, ?)`
+		_, err := dbExec(ctx, query, entityID, source.Name(), source.Description(), isVisible, shared.TimestampNow())
+		if err != nil {
+			return err
+		}
+
+		const trackQuery = "INSERT INTO synced_playlist_track (entity_id, position, id_on_remote) VALUES (?, ?, ?)"
+		for i, id := range trackIDs {
+			if _, err := dbExec(ctx, trackQuery, entityID, i, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Source playlist state at last sync.
+type SyncedPlaylist struct {
+	HEntityID    shared.EntityID `db:"entity_id"`
+	HName        string          `db:"name"`
+	HDescription *string         `db:"description"`
+	HIsVisible   *bool           `db:"is_visible"`
+	HSyncedAt    int64           `db:"synced_at"`
+}
+
+func (e SyncedPlaylist) Name() string {
+	return e.HName
+}
+
+// Nil if remote not supports descriptions.
+func (e SyncedPlaylist) Description() *string {
+	return e.HDescription
+}
+
+// Nil if remote not supports visibility.
+func (e SyncedPlaylist) IsVisible() *bool {
+	return e.HIsVisible
+}
+
+func (e SyncedPlaylist) SyncedAt() time.Time {
+	return shared.Time(e.HSyncedAt)
+}
+
+// Source tracks IDs (by position).
+func (e SyncedPlaylist) Tracks(ctx context.Context) ([]shared.RemoteID, error) {
+	const query = "SELECT * FROM synced_playlist_track WHERE entity_id=? ORDER BY position"
+	rows, err := dbGetMany[syncedPlaylistTrack](ctx, query, nil, e.HEntityID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]shared.RemoteID, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].IdOnRemote
+	}
+	return ids, err
+}
+
+type syncedPlaylistTrack struct {
+	HEntityID  shared.EntityID `db:"entity_id"`
+	HPosition  int             `db:"position"`
+	IdOnRemote shared.RemoteID `db:"id_on_remote"`
+}
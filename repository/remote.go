@@ -59,18 +59,23 @@ func (e *Remote) CreateAccount(alias string, auth string) (shared.Account, error
 		alias = e.Name().String() + " " + shared.GenerateULID()
 	}
 
-	const query = "INSERT INTO account (id, remote_name, alias, auth, added_at) VALUES (?, ?, ?, ?, ?) RETURNING *"
+	id := genRepositoryID()
+	encrypted, err := encryptAuth(context.Background(), auth, id.String())
+	if err != nil {
+		return nil, err
+	}
 
-	return dbGetOne[Account](context.Background(), query, genRepositoryID(), e.Name(), alias, auth, shared.TimestampNow())
+	const query = "INSERT INTO account (id, remote_name, alias, auth, added_at) VALUES (?, ?, ?, ?, ?) RETURNING *"
+	return accountGetOne(context.Background(), query, id, e.Name(), alias, encrypted, shared.TimestampNow())
 }
 
 func (e *Remote) Accounts(ctx context.Context) ([]shared.Account, error) {
 	const query = "SELECT * FROM account WHERE remote_name=?"
-	return dbGetManyConvert[Account, shared.Account](ctx, nil, query, e.Name())
+	return dbGetManyConvert[Account, shared.Account](ctx, (*Account).decrypt, query, e.Name())
 }
 
 func (e *Remote) Account(id shared.RepositoryID) (shared.Account, error) {
-	return dbGetOne[Account](context.Background(), "SELECT * FROM account WHERE remote_name=? AND id=? LIMIT 1", e.Name(), id)
+	return accountGetOne(context.Background(), "SELECT * FROM account WHERE remote_name=? AND id=? LIMIT 1", e.Name(), id)
 }
 
 func (e Remote) Actions() (shared.RemoteActions, error) {
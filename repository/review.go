@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/shared"
+)
+
+var errReviewNotLinked = errors.New("review: entity not linked anymore")
+
+func (e EntityRepository) AddReview(ctx context.Context, entityID shared.EntityID, source linker.RemoteEntity, target shared.RemoteName, candidates []linker.MatchCandidate) error {
+	return Transaction(ctx, func(ctx context.Context) error {
+		return e.addReview(ctx, entityID, source, target, candidates)
+	})
+}
+
+func (e EntityRepository) addReview(ctx context.Context, entityID shared.EntityID, source linker.RemoteEntity, target shared.RemoteName, candidates []linker.MatchCandidate) error {
+	const deleteQuery = "DELETE FROM review WHERE entity_name=? AND entity_id=? AND target_remote_name=?"
+	if _, err := dbExec(ctx, deleteQuery, e.name, entityID, target); err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO review (id, entity_name, entity_id, source_remote_name, source_id_on_remote, source_name, target_remote_name, added_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	reviewID := genRepositoryID()
+	_, err := dbExec(ctx, query, reviewID, e.name, entityID,
+		source.RemoteName(), source.ID(), source.Name(), target, shared.TimestampNow())
+	if err != nil {
+		return err
+	}
+
+	const candidateQuery = "INSERT INTO review_candidate (review_id, position, id_on_remote, name, score) VALUES (?, ?, ?, ?, ?)"
+	for i, cand := range candidates {
+		if _, err := dbExec(ctx, candidateQuery, reviewID, i, cand.ID, cand.Name, cand.Score); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reviews waiting for decision (oldest first).
+//
+// If entityName is empty, returns all reviews.
+func Reviews(ctx context.Context, entityName EntityName) ([]*Review, error) {
+	if len(entityName) == 0 {
+		return dbGetMany[Review](ctx, "SELECT * FROM review ORDER BY added_at", nil)
+	}
+	return dbGetMany[Review](ctx, "SELECT * FROM review WHERE entity_name=? ORDER BY added_at", nil, entityName)
+}
+
+// Ambiguous match waiting for human decision.
+type Review struct {
+	HID               shared.RepositoryID `db:"id"`
+	HEntityName       EntityName          `db:"entity_name"`
+	HEntityID         shared.EntityID     `db:"entity_id"`
+	HSourceRemoteName shared.RemoteName   `db:"source_remote_name"`
+	HSourceID         shared.RemoteID     `db:"source_id_on_remote"`
+	HSourceName       string              `db:"source_name"`
+	HTargetRemoteName shared.RemoteName   `db:"target_remote_name"`
+	HAddedAt          int64               `db:"added_at"`
+}
+
+func (e Review) ID() shared.RepositoryID {
+	return e.HID
+}
+
+// Example: track.
+func (e Review) EntityName() EntityName {
+	return e.HEntityName
+}
+
+// Example: Spotify.
+func (e Review) SourceRemoteName() shared.RemoteName {
+	return e.HSourceRemoteName
+}
+
+// Example: Spotify track ID.
+func (e Review) SourceID() shared.RemoteID {
+	return e.HSourceID
+}
+
+// Example: Spotify track name.
+func (e Review) SourceName() string {
+	return e.HSourceName
+}
+
+// Example: Zvuk.
+func (e Review) TargetRemoteName() shared.RemoteName {
+	return e.HTargetRemoteName
+}
+
+// Candidates from target remote (best first).
+func (e Review) Candidates(ctx context.Context) ([]*ReviewCandidate, error) {
+	const query = "SELECT * FROM review_candidate WHERE review_id=? ORDER BY position"
+	return dbGetMany[ReviewCandidate](ctx, query, nil, e.HID)
+}
+
+// Link entity with target remote ID, pin link and delete review.
+//
+// Nil id - entity missing in target.
+func (e Review) Resolve(id *shared.RemoteID) error {
+	err := Transaction(context.Background(), func(ctx context.Context) error {
+		return e.resolve(ctx, id)
+	})
+	if errors.Is(err, errReviewNotLinked) {
+		if err := e.Delete(); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (e Review) resolve(ctx context.Context, id *shared.RemoteID) error {
+	linked, err := NewLinkableEntity(e.HEntityName, e.HTargetRemoteName).LinkedEntity(ctx, e.HEntityID)
+	if err != nil {
+		return err
+	}
+	if shared.IsNil(linked) {
+		return errReviewNotLinked
+	}
+
+	match := linker.MatchResult{Method: linker.MatchMethodManual}
+	if id != nil {
+		match.Score = 1
+	}
+	if err := linked.SetRemoteID(ctx, id, match); err != nil {
+		return err
+	}
+	if err := linked.SetPinned(ctx, true); err != nil {
+		return err
+	}
+
+	return e.delete(ctx)
+}
+
+func (e Review) Delete() error {
+	return e.delete(context.Background())
+}
+
+func (e Review) delete(ctx context.Context) error {
+	_, err := dbExec(ctx, "DELETE FROM review WHERE id=?", e.HID)
+	return err
+}
+
+type ReviewCandidate struct {
+	HReviewID  shared.RepositoryID `db:"review_id"`
+	HPosition  int                 `db:"position"`
+	IdOnRemote shared.RemoteID     `db:"id_on_remote"`
+	HName      string              `db:"name"`
+	HScore     float64             `db:"score"`
+}
+
+func (e ReviewCandidate) ID() shared.RemoteID {
+	return e.IdOnRemote
+}
+
+func (e ReviewCandidate) Name() string {
+	return e.HName
+}
+
+func (e ReviewCandidate) Score() float64 {
+	return e.HScore
+}
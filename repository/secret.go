@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Accounts auth encryption (XChaCha20-Poly1305, key from passphrase by Argon2id).
+//
+// Encrypted auth: "enc1:" + base64(salt + nonce + ciphertext).
+// Account ID used as associated data, so auth can't be moved to another account.
+
+const _encryptedPrefix = "enc1:"
+
+const _saltSize = 16
+
+var (
+	ErrNoKey    = errors.New("accounts are encrypted, but key not set (set SYNCHRO_KEY or SYNCHRO_KEY_FILE)")
+	ErrWrongKey = errors.New("wrong accounts key")
+)
+
+var _secret = &secret{keys: map[string][]byte{}}
+
+type secret struct {
+	mu sync.Mutex
+
+	// Empty - don't encrypt.
+	passphrase string
+
+	// Derived keys by salt.
+	keys map[string][]byte
+
+	// Salt for new encrypted values.
+	salt []byte
+}
+
+// Set accounts passphrase. Must be called once, before accounts used.
+//
+// Empty - don't encrypt (new auth refused, if some accounts encrypted).
+func SetPassphrase(passphrase string) error {
+	_secret.mu.Lock()
+	defer _secret.mu.Unlock()
+	return _secret.set(passphrase)
+}
+
+// Some accounts auth encrypted?
+func AccountsEncrypted(ctx context.Context) (bool, error) {
+	count, err := dbGetOneSimple[int](ctx, "SELECT count(*) FROM account WHERE auth LIKE ?", _encryptedPrefix+"%")
+	if err != nil {
+		return false, err
+	}
+	return *count > 0, err
+}
+
+// Decrypt one of encrypted accounts, so wrong passphrase found at start,
+// before auth encrypted with it written.
+//
+// Nothing checked without passphrase.
+func CheckPassphrase(ctx context.Context) error {
+	if !_secret.hasPassphrase() {
+		return nil
+	}
+	const query = "SELECT * FROM account WHERE auth LIKE ? LIMIT 1"
+	acc, err := dbGetOne[Account](ctx, query, _encryptedPrefix+"%")
+	if err != nil || acc == nil {
+		return err
+	}
+	return acc.decrypt()
+}
+
+// Encrypt auth with current passphrase.
+//
+// Without passphrase, plain text allowed only if no accounts encrypted.
+func encryptAuth(ctx context.Context, auth string, accountID string) (string, error) {
+	if !_secret.hasPassphrase() {
+		encrypted, err := AccountsEncrypted(ctx)
+		if err != nil {
+			return "", err
+		}
+		if encrypted {
+			return "", ErrNoKey
+		}
+	}
+	return _secret.encrypt(auth, accountID)
+}
+
+func (e *secret) hasPassphrase() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.passphrase) > 0
+}
+
+// Set passphrase. Must be called with mu locked.
+func (e *secret) set(passphrase string) error {
+	salt := make([]byte, _saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	e.passphrase = passphrase
+	e.salt = salt
+	e.keys = map[string][]byte{}
+	return nil
+}
+
+// Must be called with mu locked.
+func (e *secret) key(salt []byte) []byte {
+	if key, ok := e.keys[string(salt)]; ok {
+		return key
+	}
+	key := argon2.IDKey([]byte(e.passphrase), salt, 1, 64*1024, 4, chacha20poly1305.KeySize)
+	e.keys[string(salt)] = key
+	return key
+}
+
+// Plain text if passphrase empty.
+func (e *secret) encrypt(auth string, accountID string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.passphrase) == 0 {
+		return auth, nil
+	}
+
+	aead, err := chacha20poly1305.NewX(e.key(e.salt))
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	data := append(append([]byte{}, e.salt...), nonce...)
+	data = aead.Seal(data, nonce, []byte(auth), []byte(accountID))
+	return _encryptedPrefix + base64.StdEncoding.EncodeToString(data), err
+}
+
+// Not encrypted auth returned as is.
+func (e *secret) decrypt(auth string, accountID string) (string, error) {
+	encoded, ok := strings.CutPrefix(auth, _encryptedPrefix)
+	if !ok {
+		return auth, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(data) < _saltSize+chacha20poly1305.NonceSizeX {
+		return "", fmt.Errorf("account %s: broken encrypted auth", accountID)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.passphrase) == 0 {
+		return "", ErrNoKey
+	}
+
+	salt, data := data[:_saltSize], data[_saltSize:]
+	nonce, data := data[:chacha20poly1305.NonceSizeX], data[chacha20poly1305.NonceSizeX:]
+	aead, err := chacha20poly1305.NewX(e.key(salt))
+	if err != nil {
+		return "", err
+	}
+	plain, err := aead.Open(nil, nonce, data, []byte(accountID))
+	if err != nil {
+		return "", ErrWrongKey
+	}
+	return string(plain), err
+}
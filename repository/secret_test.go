@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAccountsEncryption(t *testing.T) {
+	ctx := context.Background()
+	remote := bootSecretTest(t)
+
+	// Encrypt / decrypt.
+	if err := SetPassphrase("first"); err != nil {
+		t.Fatal(err)
+	}
+	created, err := remote.CreateAccount("acc", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc := created.(*Account)
+	if !strings.HasPrefix(acc.HAuth, _encryptedPrefix) || acc.Auth() != "token" {
+		t.Fatalf("not encrypted: %s", acc.HAuth)
+	}
+	got, err := remote.Account(acc.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Auth() != "token" {
+		t.Fatalf("expected token, got %s", got.Auth())
+	}
+
+	// Wrong passphrase.
+	if err := SetPassphrase("wrong"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := remote.Account(acc.ID()); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("expected ErrWrongKey, got %v", err)
+	}
+
+	// No passphrase: plain text not allowed next to encrypted.
+	if err := SetPassphrase(""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := remote.Account(acc.ID()); !errors.Is(err, ErrNoKey) {
+		t.Fatalf("expected ErrNoKey, got %v", err)
+	}
+	if err := acc.SetAuth("refreshed"); !errors.Is(err, ErrNoKey) {
+		t.Fatalf("expected ErrNoKey, got %v", err)
+	}
+	if _, err := remote.CreateAccount("acc2", "token2"); !errors.Is(err, ErrNoKey) {
+		t.Fatalf("expected ErrNoKey, got %v", err)
+	}
+
+	// Rekey.
+	if err := SetPassphrase("first"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Rekey(ctx, "second"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetPassphrase("first"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := remote.Account(acc.ID()); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("expected ErrWrongKey after rekey, got %v", err)
+	}
+	if err := SetPassphrase("second"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = remote.Account(acc.ID()); err != nil || got.Auth() != "token" {
+		t.Fatalf("after rekey: %v", err)
+	}
+
+	// Rekey to plain text.
+	count, err := Rekey(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 account, got %d", count)
+	}
+	if got, err = remote.Account(acc.ID()); err != nil || got.(*Account).HAuth != "token" {
+		t.Fatalf("expected plain text: %v", err)
+	}
+	if err := got.SetAuth("refreshed"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckPassphrase(t *testing.T) {
+	ctx := context.Background()
+	remote := bootSecretTest(t)
+
+	// Nothing encrypted.
+	if err := SetPassphrase("first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPassphrase(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := remote.CreateAccount("acc", "token"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPassphrase(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SetPassphrase("wrong"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPassphrase(ctx); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("expected ErrWrongKey, got %v", err)
+	}
+
+	// Without passphrase accounts can't be used, but nothing to check.
+	if err := SetPassphrase(""); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPassphrase(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func bootSecretTest(t *testing.T) *Remote {
+	bootTest(t, "Test")
+	t.Cleanup(func() {
+		SetPassphrase("")
+	})
+	remote, err := dbGetOne[Remote](context.Background(), "SELECT * FROM remote WHERE name=?", "Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return remote
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/shared"
+)
+
+var (
+	_ linker.RepositoryShareable = ArtistEntity
+	_ linker.RepositoryShareable = AlbumEntity
+	_ linker.RepositoryShareable = TrackEntity
+)
+
+func (e EntityRepository) Export(ctx context.Context) ([]linker.SharedEntity, error) {
+	query := fmt.Sprintf("SELECT * FROM linked_%s ORDER BY entity_id, remote_name", e.name)
+	links, err := dbGetMany[LinkedEntity](ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []linker.SharedEntity{}
+	for i := 0; i < len(links); {
+		entityID := links[i].HEntityID
+		ent := linker.SharedEntity{}
+		hasID := false
+		for ; i < len(links) && links[i].HEntityID == entityID; i++ {
+			ent.Links = append(ent.Links, links[i].shared())
+			hasID = hasID || links[i].IdOnRemote != nil
+		}
+		// Nothing to find entity by.
+		if hasID {
+			result = append(result, ent)
+		}
+	}
+	return result, err
+}
+
+func (e EntityRepository) Import(ctx context.Context, entities []linker.SharedEntity, rule linker.ConflictRule) (linker.ShareImportResult, error) {
+	result := linker.ShareImportResult{}
+	for _, ent := range entities {
+		err := Transaction(ctx, func(ctx context.Context) error {
+			return e.importEntity(ctx, ent, rule, &result)
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func (e EntityRepository) importEntity(ctx context.Context, ent linker.SharedEntity, rule linker.ConflictRule, result *linker.ShareImportResult) error {
+	links := []linker.SharedLink{}
+	var entityID *shared.EntityID
+	for _, link := range ent.Links {
+		if _, ok := Remotes[link.Remote]; !ok {
+			result.Skipped++
+			continue
+		}
+		links = append(links, link)
+		if entityID != nil || link.ID == nil {
+			continue
+		}
+		existing, err := NewLinkableEntity(e.name, link.Remote).LinkedRemoteID(ctx, *link.ID)
+		if err != nil {
+			return err
+		}
+		if !shared.IsNil(existing) {
+			id := existing.EntityID()
+			entityID = &id
+		}
+	}
+
+	if entityID == nil {
+		hasID := false
+		for _, link := range links {
+			hasID = hasID || link.ID != nil
+		}
+		if !hasID {
+			result.Skipped += len(links)
+			return nil
+		}
+		id, err := e.CreateEntity(ctx)
+		if err != nil {
+			return err
+		}
+		entityID = &id
+	}
+
+	for _, link := range links {
+		if err := e.importLink(ctx, *entityID, link, rule, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e EntityRepository) importLink(ctx context.Context, entityID shared.EntityID, theirs linker.SharedLink, rule linker.ConflictRule, result *linker.ShareImportResult) error {
+	linkable := NewLinkableEntity(e.name, theirs.Remote)
+	mine, err := linkable.linkedEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	if mine != nil && sameRemoteID(mine.IdOnRemote, theirs.ID) {
+		result.Skipped++
+		return nil
+	}
+	if mine != nil && !rule.Replace(mine.shared(), theirs) {
+		result.Kept++
+		return nil
+	}
+
+	// Don't merge entities.
+	if theirs.ID != nil {
+		other, err := linkable.LinkedRemoteID(ctx, *theirs.ID)
+		if err != nil {
+			return err
+		}
+		if !shared.IsNil(other) && other.EntityID() != entityID {
+			result.Skipped++
+			return nil
+		}
+	}
+
+	if mine == nil {
+		query := fmt.Sprintf(`INSERT INTO linked_%s (id, entity_id, remote_name, id_on_remote, modified_at, match_score, match_method, matcher_version, pinned)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, e.name)
+		_, err = dbExec(ctx, query, genRepositoryID(), entityID, theirs.Remote, theirs.ID, shared.TimestampNow(),
+			theirs.Score, matchMethodToDB(theirs.Method), theirs.Version, theirs.Pinned)
+		if err == nil {
+			result.Created++
+		}
+		return err
+	}
+
+	query := fmt.Sprintf(`UPDATE linked_%s SET id_on_remote=?,modified_at=?,
+	match_score=?,match_method=?,matcher_version=?,pinned=? WHERE id=?`, e.name)
+	_, err = dbExec(ctx, query, theirs.ID, shared.TimestampNow(),
+		theirs.Score, matchMethodToDB(theirs.Method), theirs.Version, theirs.Pinned, mine.HID)
+	if err == nil {
+		result.Replaced++
+	}
+	return err
+}
+
+func (e LinkedEntity) shared() linker.SharedLink {
+	match := e.Match()
+	return linker.SharedLink{
+		Remote:     e.HRemoteName,
+		ID:         e.IdOnRemote,
+		Score:      match.Score,
+		Method:     match.Method,
+		Version:    match.Version,
+		Pinned:     e.HPinned,
+		ModifiedAt: e.ModifiedAt(),
+	}
+}
+
+func sameRemoteID(a, b *shared.RemoteID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/shared"
+)
+
+func TestImportConflictRules(t *testing.T) {
+	const (
+		remoteA shared.RemoteName = "A"
+		remoteB shared.RemoteName = "B"
+	)
+	var (
+		idA    = shared.RemoteID("a")
+		mine   = shared.RemoteID("mine")
+		theirs = shared.RemoteID("theirs")
+	)
+
+	cases := []struct {
+		rule        linker.ConflictRule
+		theirsScore float64
+		expected    shared.RemoteID
+	}{
+		{linker.ConflictKeepMine, 1, mine},
+		{linker.ConflictTakeTheirs, 0.1, theirs},
+		{linker.ConflictHigherScore, 0.4, mine},
+		{linker.ConflictHigherScore, 0.9, theirs},
+	}
+
+	for _, c := range cases {
+		t.Run(string(c.rule), func(t *testing.T) {
+			bootTest(t, remoteA, remoteB)
+			ctx := context.Background()
+
+			// Entity linked with both remotes.
+			entityID, err := TrackEntity.CreateEntity(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := NewLinkableEntity(EntityNameTrack, remoteA).CreateLink(ctx, entityID, &idA, linker.MatchResult{Score: 1}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := NewLinkableEntity(EntityNameTrack, remoteB).CreateLink(ctx, entityID, &mine, linker.MatchResult{Score: 0.5}); err != nil {
+				t.Fatal(err)
+			}
+
+			// Same entity (found by A), other link on B.
+			imported := []linker.SharedEntity{{Links: []linker.SharedLink{
+				{Remote: remoteA, ID: &idA, Score: 1},
+				{Remote: remoteB, ID: &theirs, Score: c.theirsScore},
+			}}}
+			result, err := TrackEntity.Import(ctx, imported, c.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Created != 0 || result.Skipped != 1 || result.Kept+result.Replaced != 1 {
+				t.Fatalf("bad result: %+v", result)
+			}
+
+			linked, err := NewLinkableEntity(EntityNameTrack, remoteB).LinkedEntity(ctx, entityID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := *linked.RemoteID(); got != c.expected {
+				t.Fatalf("score %v: expected %s, got %s", c.theirsScore, c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+// Key of accounts synced together. Same for any accounts order.
+func SyncKey(accountIDs []shared.RepositoryID) string {
+	ids := make([]string, len(accountIDs))
+	for i := range accountIDs {
+		ids[i] = accountIDs[i].String()
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
+// Returns nil, nil if account not synced before.
+func LikedSnapshot(syncKey string, accountID shared.RepositoryID, entityName EntityName) (*Snapshot, error) {
+	const query = "SELECT * FROM snapshot WHERE sync_key=? AND account_id=? AND entity_name=? LIMIT 1"
+	return dbGetOne[Snapshot](context.Background(), query, syncKey, accountID, entityName)
+}
+
+// Replace previous snapshot.
+//
+// In transaction, so failure not leaves truncated snapshot (missing IDs will be unliked by next sync).
+func SaveLikedSnapshot(ctx context.Context, syncKey string, accountID shared.RepositoryID, entityName EntityName, ids []shared.RemoteID) error {
+	return Transaction(ctx, func(ctx context.Context) error {
+		const deleteQuery = "DELETE FROM snapshot WHERE sync_key=? AND account_id=? AND entity_name=?"
+		if _, err := dbExec(ctx, deleteQuery, syncKey, accountID, entityName); err != nil {
+			return err
+		}
+
+		const query = `INSERT INTO snapshot (id, sync_key, account_id, entity_name, created_at)
+		VALUES (?, ?, ?, ?, ?)`
+		snapshotID := genRepositoryID()
+		if _, err := dbExec(ctx, query, snapshotID, syncKey, accountID, entityName, shared.TimestampNow()); err != nil {
+			return err
+		}
+
+		const likedQuery = "INSERT OR IGNORE INTO snapshot_liked (snapshot_id, id_on_remote) VALUES (?, ?)"
+		for _, id := range ids {
+			if _, err := dbExec(ctx, likedQuery, snapshotID, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Liked entities of account at last sync.
+type Snapshot struct {
+	HID         shared.RepositoryID `db:"id"`
+	HSyncKey    string              `db:"sync_key"`
+	HAccountID  shared.RepositoryID `db:"account_id"`
+	HEntityName EntityName          `db:"entity_name"`
+	HCreatedAt  int64               `db:"created_at"`
+}
+
+func (e Snapshot) CreatedAt() time.Time {
+	return shared.Time(e.HCreatedAt)
+}
+
+// Liked entities IDs.
+func (e Snapshot) Liked(ctx context.Context) ([]shared.RemoteID, error) {
+	const query = "SELECT id_on_remote FROM snapshot_liked WHERE snapshot_id=?"
+	rows, err := dbGetMany[snapshotLiked](ctx, query, nil, e.HID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]shared.RemoteID, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].IdOnRemote
+	}
+	return ids, err
+}
+
+type snapshotLiked struct {
+	IdOnRemote shared.RemoteID `db:"id_on_remote"`
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklookat/synchro/shared"
+)
+
+type TransferJobStatus string
+
+const (
+	TransferJobStatusRunning TransferJobStatus = "running"
+	TransferJobStatusFailed  TransferJobStatus = "failed"
+	TransferJobStatusDone    TransferJobStatus = "done"
+
+	// Changes made in target reverted.
+	TransferJobStatusUndone TransferJobStatus = "undone"
+)
+
+type TransferItemStatus string
+
+const (
+	// Not linked yet.
+	TransferItemStatusPending TransferItemStatus = "pending"
+
+	// Found in target, but not liked yet.
+	TransferItemStatusLinked TransferItemStatus = "linked"
+
+	// Liked (or added to playlist) in target.
+	TransferItemStatusLiked TransferItemStatus = "liked"
+
+	// Not found in target.
+	TransferItemStatusMissing TransferItemStatus = "missing"
+
+	TransferItemStatusFailed TransferItemStatus = "failed"
+)
+
+// Change made in target by transfer.
+type TransferChangeAction string
+
+const (
+	// Liked, or added to playlist (section target).
+	TransferChangeAdded TransferChangeAction = "added"
+
+	// Playlist created.
+	TransferChangeCreated TransferChangeAction = "created"
+
+	// Unliked (mirror), or removed from playlist (section target).
+	TransferChangeRemoved TransferChangeAction = "removed"
+)
+
+// Options: any text (like JSON) to resume job with same options.
+func CreateTransferJob(fromAccountID, toAccountID shared.RepositoryID, options string) (*TransferJob, error) {
+	const query = `INSERT INTO transfer_job (id, from_account_id, to_account_id, options, status, created_at, modified_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING *`
+	now := shared.TimestampNow()
+	return dbGetOne[TransferJob](context.Background(), query, genRepositoryID(),
+		fromAccountID, toAccountID, options, TransferJobStatusRunning, now, now)
+}
+
+// Returns nil, nil if job not found.
+func TransferJobByID(id shared.RepositoryID) (*TransferJob, error) {
+	const query = "SELECT * FROM transfer_job WHERE id=? LIMIT 1"
+	return dbGetOne[TransferJob](context.Background(), query, id)
+}
+
+// All jobs (newest first).
+func TransferJobs(ctx context.Context) ([]*TransferJob, error) {
+	const query = "SELECT * FROM transfer_job ORDER BY created_at DESC"
+	return dbGetMany[TransferJob](ctx, query, nil)
+}
+
+// Persisted transfer between accounts.
+type TransferJob struct {
+	HID            shared.RepositoryID `db:"id"`
+	HFromAccountID shared.RepositoryID `db:"from_account_id"`
+	HToAccountID   shared.RepositoryID `db:"to_account_id"`
+	HOptions       string              `db:"options"`
+	HStatus        TransferJobStatus   `db:"status"`
+	HCreatedAt     int64               `db:"created_at"`
+	HModifiedAt    int64               `db:"modified_at"`
+}
+
+func (e TransferJob) ID() shared.RepositoryID {
+	return e.HID
+}
+
+func (e TransferJob) FromAccountID() shared.RepositoryID {
+	return e.HFromAccountID
+}
+
+func (e TransferJob) ToAccountID() shared.RepositoryID {
+	return e.HToAccountID
+}
+
+func (e TransferJob) Options() string {
+	return e.HOptions
+}
+
+func (e TransferJob) Status() TransferJobStatus {
+	return e.HStatus
+}
+
+func (e *TransferJob) SetStatus(status TransferJobStatus) error {
+	const query = "UPDATE transfer_job SET status=?,modified_at=? WHERE id=?"
+	now := shared.TimestampNow()
+	_, err := dbExec(context.Background(), query, status, now, e.HID)
+	if err == nil {
+		e.HStatus = status
+		e.HModifiedAt = now
+	}
+	return err
+}
+
+func (e TransferJob) CreatedAt() time.Time {
+	return shared.Time(e.HCreatedAt)
+}
+
+// Get or create job section.
+//
+// Kind: any text like "likedTracks".
+//
+// SourceID: empty if section is not remote entity (like liked tracks), or playlist ID.
+func (e TransferJob) Section(kind string, sourceID shared.RemoteID) (*TransferSection, error) {
+	ctx := context.Background()
+	const query = "SELECT * FROM transfer_section WHERE job_id=? AND kind=? AND source_id_on_remote=? LIMIT 1"
+	section, err := dbGetOne[TransferSection](ctx, query, e.HID, kind, sourceID)
+	if err != nil || section != nil {
+		return section, err
+	}
+	const insertQuery = `INSERT INTO transfer_section (id, job_id, kind, source_id_on_remote)
+	VALUES (?, ?, ?, ?) RETURNING *`
+	return dbGetOne[TransferSection](ctx, insertQuery, genRepositoryID(), e.HID, kind, sourceID)
+}
+
+// All job sections.
+func (e TransferJob) Sections(ctx context.Context) ([]*TransferSection, error) {
+	const query = "SELECT * FROM transfer_section WHERE job_id=?"
+	return dbGetMany[TransferSection](ctx, query, nil, e.HID)
+}
+
+// Part of transfer. Example: liked tracks, or one playlist.
+type TransferSection struct {
+	HID       shared.RepositoryID `db:"id"`
+	HJobID    shared.RepositoryID `db:"job_id"`
+	HKind     string              `db:"kind"`
+	HSourceID shared.RemoteID     `db:"source_id_on_remote"`
+	HTargetID *shared.RemoteID    `db:"target_id_on_remote"`
+	HDone     bool                `db:"is_done"`
+}
+
+func (e TransferSection) ID() shared.RepositoryID {
+	return e.HID
+}
+
+func (e TransferSection) Kind() string {
+	return e.HKind
+}
+
+func (e TransferSection) SourceID() shared.RemoteID {
+	return e.HSourceID
+}
+
+// Example: ID of playlist created in target.
+//
+// Nil if not set.
+func (e TransferSection) TargetID() *shared.RemoteID {
+	return e.HTargetID
+}
+
+func (e *TransferSection) SetTargetID(id *shared.RemoteID) error {
+	const query = "UPDATE transfer_section SET target_id_on_remote=? WHERE id=?"
+	_, err := dbExec(context.Background(), query, id, e.HID)
+	if err == nil {
+		e.HTargetID = id
+	}
+	return err
+}
+
+func (e TransferSection) Done() bool {
+	return e.HDone
+}
+
+func (e *TransferSection) SetDone(done bool) error {
+	const query = "UPDATE transfer_section SET is_done=? WHERE id=?"
+	_, err := dbExec(context.Background(), query, done, e.HID)
+	if err == nil {
+		e.HDone = done
+	}
+	return err
+}
+
+// Section items (by position).
+func (e TransferSection) Items(ctx context.Context) ([]*TransferItem, error) {
+	const query = "SELECT * FROM transfer_item WHERE section_id=? ORDER BY position"
+	return dbGetMany[TransferItem](ctx, query, nil, e.HID)
+}
+
+// Save changes made in target.
+func (e TransferSection) AddChanges(ctx context.Context, action TransferChangeAction, ids []shared.RemoteID) error {
+	const query = `INSERT OR IGNORE INTO transfer_change (section_id, action, id_on_remote, created_at)
+	VALUES (?, ?, ?, ?)`
+	now := shared.TimestampNow()
+	for _, id := range ids {
+		if _, err := dbExec(ctx, query, e.HID, action, id, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IDs of entities changed in target.
+func (e TransferSection) Changes(ctx context.Context, action TransferChangeAction) ([]shared.RemoteID, error) {
+	const query = "SELECT id_on_remote FROM transfer_change WHERE section_id=? AND action=? ORDER BY created_at"
+	rows, err := dbGetMany[transferChange](ctx, query, nil, e.HID, action)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]shared.RemoteID, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].IdOnRemote
+	}
+	return ids, err
+}
+
+// Delete changes (after undo).
+func (e TransferSection) DeleteChanges(ctx context.Context) error {
+	const query = "DELETE FROM transfer_change WHERE section_id=?"
+	_, err := dbExec(ctx, query, e.HID)
+	return err
+}
+
+type transferChange struct {
+	IdOnRemote shared.RemoteID `db:"id_on_remote"`
+}
+
+// Get items for entities from source.
+//
+// Result has same length and order as entities.
+// Creates pending items for entities that not in section yet.
+func (e TransferSection) SyncItems(ctx context.Context, entities []shared.RemoteEntity) ([]*TransferItem, error) {
+	existing, err := e.Items(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same entity can be in section many times (example: playlist).
+	bySourceID := make(map[shared.RemoteID][]*TransferItem, len(existing))
+	for _, item := range existing {
+		bySourceID[item.HSourceID] = append(bySourceID[item.HSourceID], item)
+	}
+
+	position := len(existing)
+	result := make([]*TransferItem, len(entities))
+	const query = `INSERT INTO transfer_item (id, section_id, position, source_id_on_remote, name, status, modified_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING *`
+	for i, ent := range entities {
+		if items := bySourceID[ent.ID()]; len(items) > 0 {
+			result[i] = items[0]
+			bySourceID[ent.ID()] = items[1:]
+			continue
+		}
+		item, err := dbGetOne[TransferItem](ctx, query, genRepositoryID(), e.HID, position,
+			ent.ID(), ent.Name(), TransferItemStatusPending, shared.TimestampNow())
+		if err != nil {
+			return nil, err
+		}
+		result[i] = item
+		position++
+	}
+
+	return result, nil
+}
+
+// Entity from source.
+type TransferItem struct {
+	HID         shared.RepositoryID `db:"id"`
+	HSectionID  shared.RepositoryID `db:"section_id"`
+	HPosition   int                 `db:"position"`
+	HSourceID   shared.RemoteID     `db:"source_id_on_remote"`
+	HName       string              `db:"name"`
+	HTargetID   *shared.RemoteID    `db:"target_id_on_remote"`
+	HStatus     TransferItemStatus  `db:"status"`
+	HError      *string             `db:"error"`
+	HModifiedAt int64               `db:"modified_at"`
+}
+
+func (e TransferItem) SourceID() shared.RemoteID {
+	return e.HSourceID
+}
+
+func (e TransferItem) Name() string {
+	return e.HName
+}
+
+// Nil if not linked or missing.
+func (e TransferItem) TargetID() *shared.RemoteID {
+	return e.HTargetID
+}
+
+func (e TransferItem) Status() TransferItemStatus {
+	return e.HStatus
+}
+
+// Nil if no error.
+func (e TransferItem) Error() *string {
+	return e.HError
+}
+
+// Nil targetID - missing.
+func (e *TransferItem) SetLinked(targetID *shared.RemoteID) error {
+	status := TransferItemStatusLinked
+	if targetID == nil {
+		status = TransferItemStatusMissing
+	}
+	return e.set(status, targetID, nil)
+}
+
+func (e *TransferItem) SetLiked() error {
+	return e.set(TransferItemStatusLiked, e.HTargetID, nil)
+}
+
+func (e *TransferItem) SetFailed(reason error) error {
+	msg := reason.Error()
+	return e.set(TransferItemStatusFailed, e.HTargetID, &msg)
+}
+
+func (e *TransferItem) set(status TransferItemStatus, targetID *shared.RemoteID, errMsg *string) error {
+	const query = "UPDATE transfer_item SET status=?,target_id_on_remote=?,error=?,modified_at=? WHERE id=?"
+	now := shared.TimestampNow()
+	_, err := dbExec(context.Background(), query, status, targetID, errMsg, now, e.HID)
+	if err == nil {
+		e.HStatus = status
+		e.HTargetID = targetID
+		e.HError = errMsg
+		e.HModifiedAt = now
+	}
+	return err
+}
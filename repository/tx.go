@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type txKey struct{}
+
+// Run fn in transaction. Commit if fn returns nil, otherwise rollback.
+//
+// DB calls with ctx passed to fn made in transaction.
+// DB has one connection, so DB calls without this ctx will wait for commit.
+//
+// Nested calls use outer transaction.
+func Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := _db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Transaction from ctx, or DB.
+func dbConn(ctx context.Context) sqlx.ExtContext {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return _db
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklookat/synchro/linking/linker"
+	"github.com/oklookat/synchro/shared"
+)
+
+var errTest = errors.New("test")
+
+func TestTransactionRollback(t *testing.T) {
+	bootTest(t, "A")
+	ctx := context.Background()
+	id := shared.RemoteID("a")
+
+	err := Transaction(ctx, func(ctx context.Context) error {
+		entityID, err := TrackEntity.CreateEntity(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := NewLinkableEntity(EntityNameTrack, "A").CreateLink(ctx, entityID, &id, linker.MatchResult{}); err != nil {
+			return err
+		}
+		// Nested uses outer transaction.
+		return Transaction(ctx, func(ctx context.Context) error {
+			return errTest
+		})
+	})
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+
+	linked, err := NewLinkableEntity(EntityNameTrack, "A").LinkedRemoteID(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !shared.IsNil(linked) {
+		t.Fatal("link not rolled back")
+	}
+	count, err := dbGetOneSimple[int](ctx, "SELECT count(*) FROM track")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *count != 0 {
+		t.Fatalf("entity not rolled back: %d", *count)
+	}
+}
+
+func TestToRemoteDeleteNotLinkedFails(t *testing.T) {
+	bootTest(t, "A", "B")
+	ctx := context.Background()
+	remotes := map[shared.RemoteName]linker.Remote{
+		"A": testRemote{name: "A"},
+		"B": testRemote{name: "B"},
+	}
+
+	id := shared.RemoteID("a")
+	entityID, err := TrackEntity.CreateEntity(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceLinked, err := NewLinkableEntity(EntityNameTrack, "A").CreateLink(ctx, entityID, &id, linker.MatchResult{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := testEntity{remote: "A", id: id}
+	targetLinkables := NewLinkableEntity(EntityNameTrack, "B")
+
+	// Link with target rolled back.
+	lnk := linker.NewStatic(deleteNotLinkedFails{TrackEntity}, remotes)
+	if _, err := lnk.ToRemoteFrom(ctx, sourceLinked, source, "B"); !errors.Is(err, errTest) {
+		t.Fatalf("expected errTest, got %v", err)
+	}
+	targetLinked, err := targetLinkables.LinkedEntity(ctx, entityID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !shared.IsNil(targetLinked) {
+		t.Fatal("target link not rolled back")
+	}
+
+	// Same, without failure.
+	lnk = linker.NewStatic(TrackEntity, remotes)
+	result, err := lnk.ToRemoteFrom(ctx, sourceLinked, source, "B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Linked.RemoteID() == nil || *result.Linked.RemoteID() != id {
+		t.Fatalf("not linked: %+v", result.Linked)
+	}
+}
+
+// Repository where cleanup after linking fails.
+type deleteNotLinkedFails struct {
+	EntityRepository
+}
+
+func (e deleteNotLinkedFails) DeleteNotLinked(context.Context) error {
+	return errTest
+}
+
+// Remote where any entity found with same ID.
+type testRemote struct {
+	name shared.RemoteName
+}
+
+func (e testRemote) Name() shared.RemoteName {
+	return e.name
+}
+
+func (e testRemote) Match(_ context.Context, source linker.RemoteEntity) (linker.RemoteEntity, linker.MatchResult, error) {
+	return testEntity{remote: e.name, id: source.ID()}, linker.MatchResult{Score: 1}, nil
+}
+
+func (e testRemote) RemoteEntity(_ context.Context, id shared.RemoteID) (linker.RemoteEntity, error) {
+	return testEntity{remote: e.name, id: id}, nil
+}
+
+func (e testRemote) CachedEntity(context.Context, shared.RemoteID) linker.RemoteEntity {
+	return nil
+}
+
+func (e testRemote) Linkables() linker.Linkables {
+	return NewLinkableEntity(EntityNameTrack, e.name)
+}
+
+func (e testRemote) Limits() shared.RemoteLimits {
+	return shared.RemoteLimits{}
+}
+
+type testEntity struct {
+	remote shared.RemoteName
+	id     shared.RemoteID
+}
+
+func (e testEntity) RemoteName() shared.RemoteName {
+	return e.remote
+}
+
+func (e testEntity) ID() shared.RemoteID {
+	return e.id
+}
+
+func (e testEntity) Name() string {
+	return e.id.String()
+}
@@ -20,7 +20,7 @@ func genEntityID() shared.RepositoryID {
 
 // Get one item.
 func dbGetOne[T any](ctx context.Context, query string, args ...interface{}) (*T, error) {
-	row := _db.QueryRowxContext(ctx, query, args...)
+	row := dbConn(ctx).QueryRowxContext(ctx, query, args...)
 	out := new(T)
 	err := row.StructScan(out)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -34,7 +34,7 @@ func dbGetOne[T any](ctx context.Context, query string, args ...interface{}) (*T
 
 // Get one item into simple value like int.
 func dbGetOneSimple[T comparable](ctx context.Context, query string, args ...interface{}) (*T, error) {
-	row := _db.QueryRowxContext(ctx, query, args...)
+	row := dbConn(ctx).QueryRowxContext(ctx, query, args...)
 	out := new(T)
 	err := row.Scan(out)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -50,7 +50,7 @@ func dbGetOneSimple[T comparable](ctx context.Context, query string, args ...int
 //
 // T MUST IMPLEMENT R. (STRUCT TO INTERFACE).
 func dbGetManyConvert[T any, R any](ctx context.Context, hook func(*T) error, query string, args ...interface{}) ([]R, error) {
-	rows, err := _db.QueryxContext(ctx, query, args...)
+	rows, err := dbConn(ctx).QueryxContext(ctx, query, args...)
 
 	defer func() {
 		if err != nil {
@@ -97,7 +97,7 @@ func dbGetManyConvert[T any, R any](ctx context.Context, hook func(*T) error, qu
 }
 
 func dbGetMany[T any](ctx context.Context, query string, hook func(*T) error, args ...interface{}) ([]*T, error) {
-	rows, err := _db.QueryxContext(ctx, query, args...)
+	rows, err := dbConn(ctx).QueryxContext(ctx, query, args...)
 
 	defer func() {
 		if err != nil {
@@ -139,7 +139,7 @@ func dbGetMany[T any](ctx context.Context, query string, hook func(*T) error, ar
 
 // Exec.
 func dbExec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	result, err := _db.ExecContext(ctx, query, args...)
+	result, err := dbConn(ctx).ExecContext(ctx, query, args...)
 
 	if err != nil {
 		slog.Error(err.Error(), "dbExec", query)
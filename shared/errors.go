@@ -50,3 +50,67 @@ type ErrAccountNotExists struct {
 func (e ErrAccountNotExists) Error() string {
 	return fmt.Sprintf("%s: account not exists (id: %s)", e.Prefix, e.ID)
 }
+
+// Example: auth, rate-limit.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown   ErrorKind = "unknown"
+	ErrorKindAuth      ErrorKind = "auth"
+	ErrorKindRateLimit ErrorKind = "rate-limit"
+	ErrorKindNotFound  ErrorKind = "not-found"
+	ErrorKindRemote5xx ErrorKind = "remote-5xx"
+)
+
+func (e ErrorKind) String() string {
+	return string(e)
+}
+
+// Example: 429 - rate-limit.
+func ErrorKindFromStatus(statusCode int) ErrorKind {
+	switch {
+	case statusCode == 401 || statusCode == 403:
+		return ErrorKindAuth
+	case statusCode == 404:
+		return ErrorKindNotFound
+	case statusCode == 429:
+		return ErrorKindRateLimit
+	case statusCode >= 500:
+		return ErrorKindRemote5xx
+	}
+	return ErrorKindUnknown
+}
+
+// Kind of error returned by remote.
+//
+// ErrorKindUnknown if err is not ErrRemote.
+func ErrorKindOf(err error) ErrorKind {
+	var remErr ErrRemote
+	if errors.As(err, &remErr) {
+		return remErr.Kind
+	}
+	return ErrorKindUnknown
+}
+
+func NewErrRemote(name RemoteName, kind ErrorKind, err error) ErrRemote {
+	return ErrRemote{
+		Name: name,
+		Kind: kind,
+		Err:  err,
+	}
+}
+
+// Error returned by remote.
+type ErrRemote struct {
+	Name RemoteName
+	Kind ErrorKind
+	Err  error
+}
+
+func (e ErrRemote) Error() string {
+	return fmt.Sprintf("%s (%s): %s", e.Name.String(), e.Kind.String(), e.Err.Error())
+}
+
+func (e ErrRemote) Unwrap() error {
+	return e.Err
+}
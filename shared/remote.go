@@ -3,6 +3,7 @@ package shared
 import (
 	"context"
 	"net/url"
+	"time"
 )
 
 // Must be implemented by a remote.
@@ -30,6 +31,20 @@ type (
 
 		// Get url to entity.
 		EntityURL(etype EntityType, id RemoteID) url.URL
+
+		// Safe limits for concurrent requests.
+		Limits() RemoteLimits
+	}
+
+	// Example: 4 searches at once, but no more than 10 per second.
+	RemoteLimits struct {
+		// Max concurrent operations (like search or get entity by ID).
+		Parallelism int
+
+		// Max operations per second.
+		//
+		// One operation can make several requests.
+		Rate float64
 	}
 
 	// Remote entity.
@@ -121,6 +136,12 @@ type (
 		// Remove tracks from playlist.
 		RemoveTracks(context.Context, []RemoteID) error
 
+		// Reorder tracks to match ids. Ids: all playlist tracks, in wanted order.
+		//
+		// Returns ErrNotImplemented if remote can't reorder tracks.
+		// Then tracks can only be added in wanted order.
+		Reorder(context.Context, []RemoteID) error
+
 		// Is playlist visible?
 		//
 		// Can return error only if remote doesn't support vis.
@@ -169,6 +190,11 @@ type (
 		// Examples: get liked artists.
 		Liked(context.Context) ([]RemoteEntity, error)
 
+		// Like date of entity, got by last Liked call.
+		//
+		// Nil if remote doesn't provide like dates.
+		LikedAt(RemoteID) *time.Time
+
 		// Examples: like artists.
 		Like(ctx context.Context, ids []RemoteID) error
 
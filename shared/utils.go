@@ -50,6 +50,18 @@ func (r *RemoteName) FromString(val string) {
 	*r = conv
 }
 
+// Like dates by entity ID. For LikedActions.LikedAt.
+type LikedDates map[RemoteID]time.Time
+
+// Nil if no date.
+func (e LikedDates) At(id RemoteID) *time.Time {
+	at, ok := e[id]
+	if !ok {
+		return nil
+	}
+	return &at
+}
+
 // Compare albums, tracks, artists names.
 //
 // Max: 1.0 (same).